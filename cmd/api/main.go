@@ -12,15 +12,28 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
+	"portfolio-backend/internal/auth"
+	"portfolio-backend/internal/buildinfo"
 	"portfolio-backend/internal/config"
 	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/diagnostics"
 	"portfolio-backend/internal/handlers"
+	"portfolio-backend/internal/mail"
+	"portfolio-backend/internal/metrics"
 	"portfolio-backend/internal/middleware"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/publishing"
+	"portfolio-backend/internal/redact"
+	"portfolio-backend/internal/storage"
+	"portfolio-backend/internal/telemetry"
+	"portfolio-backend/internal/tenant"
+	"portfolio-backend/internal/trash"
+	"portfolio-backend/pkg/response"
 )
 
-const version = "1.0.0"
-
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
@@ -31,12 +44,26 @@ func main() {
 	// Setup logger
 	setupLogger(cfg.Logging)
 
+	// Select the error response format
+	response.SetProblemDetails(cfg.API.ProblemDetails)
+	response.SetRetryAfter(cfg.Database.RetryAfter)
+
+	build := buildinfo.Get()
 	log.Info().
-		Str("version", version).
+		Str("version", build.Version).
+		Str("commit", build.Commit).
+		Str("build_time", build.BuildTime).
+		Str("go_version", build.GoVersion).
 		Str("host", cfg.Server.Host).
 		Int("port", cfg.Server.Port).
 		Msg("Starting Portfolio Backend API")
 
+	// Export traces
+	shutdownTracing, err := telemetry.Setup(context.Background(), cfg.Tracing, build.Version)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to set up tracing")
+	}
+
 	// Connect to database
 	db, err := database.NewConnection(&cfg.Database)
 	if err != nil {
@@ -44,11 +71,54 @@ func main() {
 	}
 	defer db.Close()
 
+	// Store uploaded images
+	blobStore, err := storage.NewBlobStore(&cfg.Storage)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create blob store")
+	}
+
 	// Initialize handlers
-	h := handlers.NewHandlers(db.DB)
+	h := handlers.NewHandlers(db, cfg, blobStore)
+
+	// Resolve tenants from /v1/u/:slug routes and request hosts
+	tenantResolver := tenant.NewResolver(repositories.NewTenantRepository(db), cfg.Tenancy)
+
+	// Deliver queued contact notifications in the background
+	mailer, err := mail.NewMailer(&cfg.Mail)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create mailer")
+	}
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	defer stopWorker()
+	outboxWorker := mail.NewOutboxWorker(repositories.NewOutboxRepository(db), mailer, cfg.Mail)
+	go outboxWorker.Run(workerCtx)
+
+	// Publish and archive scheduled entries in the background
+	scheduler := publishing.NewScheduler(repositories.NewPublicationRepository(db), cfg.Publishing)
+	go scheduler.Run(workerCtx)
+
+	// Purge entries that outlived the trash retention
+	retentionJob := trash.NewRetentionJob(repositories.NewTrashRepository(db), cfg.Trash)
+	go retentionJob.Run(workerCtx)
+
+	// Collect Prometheus metrics when the admin listener serves them
+	var m *metrics.Metrics
+	if cfg.AdminServer.Port > 0 {
+		m = metrics.New(build)
+		m.RegisterDB(db.DB)
+	}
+
+	// Serve pprof and runtime diagnostics when ADMIN_SERVER_TOKEN is set
+	var diag *diagnostics.Diagnostics
+	if cfg.AdminServer.Port > 0 {
+		diag = diagnostics.New(cfg, build)
+	}
+	if diag != nil {
+		diag.RegisterQueryStats(db.Queries)
+	}
 
 	// Setup Gin router
-	router := setupRouter(cfg, h)
+	router := setupRouter(cfg, h, tenantResolver, m, diag)
 
 	// Create HTTP server
 	server := &http.Server{
@@ -69,6 +139,22 @@ func main() {
 		}
 	}()
 
+	// Serve operational endpoints on a separate admin listener
+	var adminServer *http.Server
+	if m != nil {
+		adminServer = setupAdminServer(cfg, m, diag)
+
+		go func() {
+			log.Info().
+				Str("address", adminServer.Addr).
+				Msg("Admin server starting")
+
+			if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatal().Err(err).Msg("Failed to start admin server")
+			}
+		}()
+	}
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -76,6 +162,8 @@ func main() {
 
 	log.Info().Msg("Shutting down server...")
 
+	stopWorker()
+
 	// Give outstanding requests 30 seconds to complete
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -83,6 +171,14 @@ func main() {
 	if err := server.Shutdown(ctx); err != nil {
 		log.Error().Err(err).Msg("Server forced to shutdown")
 	}
+	if adminServer != nil {
+		if err := adminServer.Shutdown(ctx); err != nil {
+			log.Error().Err(err).Msg("Admin server forced to shutdown")
+		}
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to flush traces")
+	}
 
 	log.Info().Msg("Server exited")
 }
@@ -111,9 +207,15 @@ func setupLogger(loggingConfig config.LoggingConfig) {
 	if loggingConfig.Level == "debug" {
 		log.Logger = log.With().Caller().Logger()
 	}
+
+	// Mask personal data in request logs
+	redact.Configure(loggingConfig)
+
+	// zerolog.Ctx falls back to the global logger outside of requests
+	zerolog.DefaultContextLogger = &log.Logger
 }
 
-func setupRouter(cfg *config.Config, h *handlers.Handlers) *gin.Engine {
+func setupRouter(cfg *config.Config, h *handlers.Handlers, tenantResolver *tenant.Resolver, m *metrics.Metrics, diag *diagnostics.Diagnostics) *gin.Engine {
 	// Set Gin mode based on environment
 	if cfg.Logging.Level != "debug" {
 		gin.SetMode(gin.ReleaseMode)
@@ -121,12 +223,25 @@ func setupRouter(cfg *config.Config, h *handlers.Handlers) *gin.Engine {
 
 	router := gin.New()
 
+	// Only believe client IP headers set by our own proxies
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatal().Err(err).Msg("Invalid TRUSTED_PROXIES")
+	}
+
+	// Record request metrics outermost, so recovered panics count as 500s
+	if m != nil {
+		router.Use(m.Middleware())
+	}
+
+	// Start a server span per request, continuing inbound traceparent headers
+	router.Use(otelgin.Middleware(telemetry.ServiceName))
+
 	// Add middleware
 	router.Use(middleware.Recovery())
 	router.Use(middleware.SecureHeaders())
 	router.Use(middleware.CORS(&cfg.CORS))
 	router.Use(middleware.CorrelationID())
-	router.Use(middleware.RequestLogger())
+	router.Use(middleware.RequestLogger(&cfg.Logging))
 	
 	// Add rate limiting
 	rateLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
@@ -136,33 +251,161 @@ func setupRouter(cfg *config.Config, h *handlers.Handlers) *gin.Engine {
 	})
 	router.Use(rateLimiter.RateLimit())
 
+	// Per-IP quota for contact submissions, shared by both portfolio route groups
+	contactLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
+		BurstSize:       cfg.Contact.QuotaPerIP,
+		QuotaPeriod:     cfg.Contact.QuotaPeriod,
+		CleanupInterval: cfg.RateLimit.CleanupInterval,
+	})
+	contactQuota := contactLimiter.RateLimit()
+
+	if m != nil {
+		m.RegisterRateLimiter("global", rateLimiter)
+		m.RegisterRateLimiter("contact", contactLimiter)
+	}
+	if diag != nil {
+		diag.RegisterRateLimiter("global", rateLimiter)
+		diag.RegisterRateLimiter("contact", contactLimiter)
+	}
+
+	// Admin bearer tokens from ADMIN_TOKENS unmask personal fields and unlock admin routes
+	authenticate := middleware.Authenticate(auth.NewAuthenticator(cfg.Auth))
+
+	portfolio := []gin.HandlerFunc{middleware.Tenant(tenantResolver), middleware.Locale(&cfg.I18n), authenticate}
+
+	// Kubernetes/Cloud Run probes; admins can add ?verbose for check details
+	probes := router.Group("", middleware.Cache(middleware.NoCacheConfig()), authenticate)
+	probes.GET("/livez", h.Health.Livez)
+	probes.GET("/readyz", h.Health.Readyz)
+	probes.GET("/startupz", h.Health.Startupz)
+
+	// Uploaded images, served from the blob store with immutable caching
+	router.GET("/media/*key", h.Images.ServeImage)
+
 	// API v1 routes
 	v1 := router.Group("/v1")
 	{
 		// Health check (no caching)
 		v1.GET("/health", middleware.Cache(middleware.NoCacheConfig()), h.Health.GetHealth)
 
-		// Profile routes (short cache)
-		v1.GET("/profile", middleware.Cache(middleware.DefaultCacheConfig()), h.Profile.GetProfile)
-		v1.PUT("/profile", h.Profile.UpdateProfile)
+		// Build metadata
+		v1.GET("/version", middleware.Cache(middleware.NoCacheConfig()), h.Version.GetVersion)
+
+		// Portfolio routes resolved by host (alice.example.com/v1/profile)
+		setupPortfolioRoutes(v1.Group("", portfolio...), h, contactQuota)
+
+		// Portfolio routes resolved by slug (/v1/u/alice/profile)
+		setupPortfolioRoutes(v1.Group("/u/:slug", portfolio...), h, contactQuota)
+	}
+
+	return router
+}
+
+// setupPortfolioRoutes registers the tenant-scoped portfolio routes on a group
+func setupPortfolioRoutes(rg *gin.RouterGroup, h *handlers.Handlers, contactQuota gin.HandlerFunc) {
+	// Profile routes (short cache)
+	rg.GET("/profile", middleware.Cache(middleware.DefaultCacheConfig()), h.Profile.GetProfile)
+	rg.PUT("/profile", middleware.RequireAdmin(), h.Profile.UpdateProfile)
+
+	// Experience routes (revalidated - published and archived on a schedule)
+	rg.GET("/experience", middleware.Cache(middleware.RevalidateCacheConfig()), h.Experience.GetAllExperiences)
+	rg.GET("/experience/:id", middleware.Cache(middleware.RevalidateCacheConfig()), h.Experience.GetExperienceByID)
+
+	// Skills routes (revalidated - published and archived on a schedule)
+	rg.GET("/skills", middleware.Cache(middleware.RevalidateCacheConfig()), h.Skills.GetSkills)
 
-		// Experience routes (long cache - relatively static)
-		v1.GET("/experience", middleware.Cache(middleware.LongCacheConfig()), h.Experience.GetAllExperiences)
-		v1.GET("/experience/:id", middleware.Cache(middleware.LongCacheConfig()), h.Experience.GetExperienceByID)
+	// Education routes (revalidated - published and archived on a schedule)
+	rg.GET("/education", middleware.Cache(middleware.RevalidateCacheConfig()), h.Education.GetEducation)
 
-		// Skills routes (long cache - relatively static)
-		v1.GET("/skills", middleware.Cache(middleware.LongCacheConfig()), h.Skills.GetSkills)
+	// Certifications routes (revalidated - published and archived on a schedule)
+	rg.GET("/certifications", middleware.Cache(middleware.RevalidateCacheConfig()), h.Certifications.GetCertifications)
 
-		// Education routes (long cache - very static)
-		v1.GET("/education", middleware.Cache(middleware.LongCacheConfig()), h.Education.GetEducation)
+	// Projects routes (revalidated - published and archived on a schedule)
+	rg.GET("/projects", middleware.Cache(middleware.RevalidateCacheConfig()), h.Projects.GetAllProjects)
+	rg.GET("/projects/:id", middleware.Cache(middleware.RevalidateCacheConfig()), h.Projects.GetProjectByID)
+	rg.PUT("/projects/:id", middleware.RequireAdmin(), h.Projects.UpdateProject)
 
-		// Certifications routes (default cache)
-		v1.GET("/certifications", middleware.Cache(middleware.DefaultCacheConfig()), h.Certifications.GetCertifications)
+	// Share card images (profile: default cache, projects: revalidated like the projects)
+	rg.GET("/og/profile.png", middleware.Cache(middleware.DefaultCacheConfig()), h.OGImages.GetProfileCard)
+	rg.GET("/og/projects/:id", middleware.Cache(middleware.RevalidateCacheConfig()), h.OGImages.GetProjectCard)
 
-		// Projects routes (default cache - may be updated occasionally)
-		v1.GET("/projects", middleware.Cache(middleware.DefaultCacheConfig()), h.Projects.GetAllProjects)
-		v1.GET("/projects/:id", middleware.Cache(middleware.DefaultCacheConfig()), h.Projects.GetProjectByID)
+	// Contact routes (never cached, submissions limited per IP)
+	rg.GET("/contact/token", middleware.Cache(middleware.NoCacheConfig()), h.Contact.GetFormToken)
+	rg.POST("/contact", contactQuota, h.Contact.SubmitContact)
+
+	// Admin routes (authenticated, never cached)
+	admin := rg.Group("/admin", middleware.RequireAdmin(), middleware.Cache(middleware.NoCacheConfig()), middleware.IncludeUnpublished())
+	{
+		// Collections including drafts, scheduled and archived entries
+		admin.GET("/experience", h.Experience.GetAllExperiences)
+		admin.GET("/experience/:id", h.Experience.GetExperienceByID)
+		admin.GET("/skills", h.Skills.GetSkills)
+		admin.GET("/education", h.Education.GetEducation)
+		admin.GET("/certifications", h.Certifications.GetCertifications)
+		admin.GET("/projects", h.Projects.GetAllProjects)
+		admin.GET("/projects/:id", h.Projects.GetProjectByID)
+
+		admin.PATCH("/experience/:id/publication", h.Publication.UpdatePublication(models.EntityExperience))
+		admin.PATCH("/skills/:id/publication", h.Publication.UpdatePublication(models.EntitySkill))
+		admin.PATCH("/education/:id/publication", h.Publication.UpdatePublication(models.EntityEducation))
+		admin.PATCH("/certifications/:id/publication", h.Publication.UpdatePublication(models.EntityCertification))
+		admin.PATCH("/projects/:id/publication", h.Publication.UpdatePublication(models.EntityProject))
+
+		// Deletions move entries to the trash until restored or purged
+		admin.DELETE("/experience/:id", h.Trash.DeleteEntry(models.EntityExperience))
+		admin.DELETE("/skills/:id", h.Trash.DeleteEntry(models.EntitySkill))
+		admin.DELETE("/education/:id", h.Trash.DeleteEntry(models.EntityEducation))
+		admin.DELETE("/certifications/:id", h.Trash.DeleteEntry(models.EntityCertification))
+		admin.DELETE("/projects/:id", h.Trash.DeleteEntry(models.EntityProject))
+		admin.GET("/trash", h.Trash.ListTrash)
+		admin.POST("/trash/:entity_type/:id/restore", h.Trash.RestoreEntry)
+		admin.DELETE("/trash/:entity_type/:id", h.Trash.PurgeEntry)
+
+		admin.GET("/messages", h.Messages.ListMessages)
+		admin.GET("/messages/export", h.Messages.ExportMessages)
+		admin.POST("/messages/mark", h.Messages.UpdateMessages)
+		admin.POST("/messages/delete", h.Messages.DeleteMessages)
+		admin.GET("/messages/:id", h.Messages.GetMessage)
+		admin.PATCH("/messages/:id", h.Messages.UpdateMessage)
+		admin.DELETE("/messages/:id", h.Messages.DeleteMessage)
+
+		admin.GET("/audit-events", h.Audit.ListAuditEvents)
+
+		admin.GET("/profile/revisions", h.Revisions.ListRevisions(models.EntityProfile))
+		admin.GET("/profile/revisions/diff", h.Revisions.DiffRevisions(models.EntityProfile))
+		admin.GET("/profile/revisions/:revision", h.Revisions.GetRevision(models.EntityProfile))
+		admin.POST("/profile/revisions/:revision/restore", h.Revisions.RestoreRevision(models.EntityProfile))
+
+		admin.POST("/projects/:id/image", h.Images.UploadProjectImage)
+		admin.POST("/projects/:id/media", h.ProjectMedia.AddMedia)
+		admin.PUT("/projects/:id/media/order", h.ProjectMedia.ReorderMedia)
+		admin.DELETE("/projects/:id/media/:media_id", h.ProjectMedia.DeleteMedia)
+
+		admin.GET("/projects/:id/revisions", h.Revisions.ListRevisions(models.EntityProject))
+		admin.GET("/projects/:id/revisions/diff", h.Revisions.DiffRevisions(models.EntityProject))
+		admin.GET("/projects/:id/revisions/:revision", h.Revisions.GetRevision(models.EntityProject))
+		admin.POST("/projects/:id/revisions/:revision/restore", h.Revisions.RestoreRevision(models.EntityProject))
 	}
+}
 
-	return router
-}
\ No newline at end of file
+// setupAdminServer creates the admin listener. It is bound to
+// ADMIN_SERVER_HOST (loopback by default) and never exposed through the
+// public router.
+func setupAdminServer(cfg *config.Config, m *metrics.Metrics, diag *diagnostics.Diagnostics) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", m.Handler())
+
+	writeTimeout := cfg.Server.WriteTimeout
+	if diag != nil {
+		diag.Register(mux)
+		// CPU profiles and execution traces stream for up to ?seconds=
+		writeTimeout = max(writeTimeout, 2*time.Minute)
+	}
+
+	return &http.Server{
+		Addr:         fmt.Sprintf("%s:%d", cfg.AdminServer.Host, cfg.AdminServer.Port),
+		Handler:      mux,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
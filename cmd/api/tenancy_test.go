@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/database/dbtest"
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/handlers"
+	"portfolio-backend/internal/tenant"
+)
+
+const (
+	alphaToken = "alpha-admin-token"
+	betaToken  = "beta-admin-token"
+)
+
+// newTenancyRouter serves the API over a database holding dbtest.Alpha and
+// dbtest.Beta, each with its own admin token
+func newTenancyRouter(t *testing.T) (*gin.Engine, *dbtest.DB) {
+	t.Helper()
+
+	t.Setenv("ADMIN_TOKENS", "alice@alpha:"+alphaToken+",bob@beta:"+betaToken)
+	t.Setenv("OG_CACHE_DIR", t.TempDir())
+
+	level := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+	t.Cleanup(func() { zerolog.SetGlobalLevel(level) })
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := dbtest.TwoTenants()
+	conn := db.Open()
+	tenantResolver := tenant.NewResolver(repositories.NewTenantRepository(conn), cfg.Tenancy)
+	return setupRouter(cfg, handlers.NewHandlers(conn, cfg, nil), tenantResolver, nil, nil), db
+}
+
+func TestPortfolioRoutesServeOnlyTheirTenant(t *testing.T) {
+	alpha, beta := dbtest.Alpha, dbtest.Beta
+
+	tests := []struct {
+		method, path, body string
+		token              string
+		status             int
+		ownRows            bool // the body shows Beta's rows
+	}{
+		{"GET", "/profile", "", "", http.StatusOK, true},
+		{"GET", "/experience", "", "", http.StatusOK, true},
+		{"GET", "/skills", "", "", http.StatusOK, true},
+		{"GET", "/education", "", "", http.StatusOK, true},
+		{"GET", "/certifications", "", "", http.StatusOK, true},
+		{"GET", "/projects", "", "", http.StatusOK, true},
+		{"GET", fmt.Sprintf("/projects/%d", beta.ProjectID), "", "", http.StatusOK, true},
+		{"GET", fmt.Sprintf("/projects/%d", alpha.ProjectID), "", "", http.StatusNotFound, false},
+		{"GET", fmt.Sprintf("/experience/%d", alpha.ExperienceID), "", "", http.StatusNotFound, false},
+
+		{"PUT", "/profile", `{"name":"Beta Renamed","title":"Engineer","location":"City","email":"beta@example.com","summary":"A renamed summary"}`, betaToken, http.StatusOK, false},
+		{"PUT", fmt.Sprintf("/projects/%d", alpha.ProjectID), `{"title":"Stolen","description":"A stolen project","technologies":["Go"],"start_date":"2026-01-01T00:00:00Z","status":"Completed"}`, betaToken, http.StatusNotFound, false},
+
+		{"GET", "/admin/projects", "", betaToken, http.StatusOK, true},
+		{"GET", fmt.Sprintf("/admin/projects/%d", alpha.ProjectID), "", betaToken, http.StatusNotFound, false},
+		{"PATCH", fmt.Sprintf("/admin/projects/%d/publication", alpha.ProjectID), `{"publication_status":"draft"}`, betaToken, http.StatusNotFound, false},
+		{"DELETE", fmt.Sprintf("/admin/projects/%d/media/%d", alpha.ProjectID, alpha.MediaID), "", betaToken, http.StatusNotFound, false},
+
+		{"GET", "/admin/messages", "", betaToken, http.StatusOK, true},
+		{"GET", fmt.Sprintf("/admin/messages/%d", beta.MessageID), "", betaToken, http.StatusOK, true},
+		{"GET", fmt.Sprintf("/admin/messages/%d", alpha.MessageID), "", betaToken, http.StatusNotFound, false},
+		{"PATCH", fmt.Sprintf("/admin/messages/%d", alpha.MessageID), `{"status":"read"}`, betaToken, http.StatusNotFound, false},
+		{"DELETE", fmt.Sprintf("/admin/messages/%d", alpha.MessageID), "", betaToken, http.StatusNotFound, false},
+
+		{"GET", "/admin/audit-events", "", betaToken, http.StatusOK, true},
+
+		{"GET", "/admin/profile/revisions/1", "", betaToken, http.StatusOK, true},
+		{"GET", fmt.Sprintf("/admin/projects/%d/revisions/1", alpha.ProjectID), "", betaToken, http.StatusNotFound, false},
+
+		{"GET", "/admin/trash", "", betaToken, http.StatusOK, false},
+		{"DELETE", fmt.Sprintf("/admin/projects/%d", alpha.ProjectID), "", betaToken, http.StatusNotFound, false},
+		{"POST", fmt.Sprintf("/admin/trash/project/%d/restore", alpha.ProjectID), "", betaToken, http.StatusNotFound, false},
+		{"DELETE", fmt.Sprintf("/admin/trash/project/%d", alpha.ProjectID), "", betaToken, http.StatusNotFound, false},
+
+		// Another tenant's admin token is refused before any query runs
+		{"GET", "/admin/messages", "", alphaToken, http.StatusForbidden, false},
+		{"GET", "/profile", "", alphaToken, http.StatusForbidden, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			router, db := newTenancyRouter(t)
+
+			req := httptest.NewRequest(tt.method, "/v1/u/"+beta.Slug+tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if strings.Contains(w.Body.String(), alpha.Marker) {
+				t.Errorf("response contains the rows of tenant %s: %s", alpha.Slug, w.Body)
+			}
+			if tt.ownRows && !strings.Contains(w.Body.String(), beta.Marker) {
+				t.Errorf("response does not contain the rows of tenant %s: %s", beta.Slug, w.Body)
+			}
+			db.AssertScoped(t, beta, alpha)
+		})
+	}
+}
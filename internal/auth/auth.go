@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"portfolio-backend/internal/config"
+)
+
+// AllTenants is the tenant of super-admin credentials, which administer
+// every portfolio
+const AllTenants = "*"
+
+type contextKey struct{}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying the authenticated actor
+func NewContext(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// ActorFromContext returns the authenticated actor stored in ctx
+func ActorFromContext(ctx context.Context) (string, bool) {
+	actor, ok := ctx.Value(contextKey{}).(string)
+	return actor, ok && actor != ""
+}
+
+// Principal is the owner of an admin token and the tenant it administers
+type Principal struct {
+	Actor  string
+	Tenant string // Tenant slug, or AllTenants
+}
+
+// SuperAdmin reports whether the principal administers every tenant
+func (p Principal) SuperAdmin() bool {
+	return p.Tenant == AllTenants
+}
+
+// Administers reports whether the principal may administer the tenant
+// with the given slug
+func (p Principal) Administers(slug string) bool {
+	return p.SuperAdmin() || strings.EqualFold(p.Tenant, slug)
+}
+
+// NewPrincipalContext returns a copy of ctx carrying the authenticated
+// principal and its actor
+func NewPrincipalContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(NewContext(ctx, p.Actor), principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal stored in ctx
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+type credential struct {
+	principal Principal
+	hash      [sha256.Size]byte
+}
+
+// Authenticator checks admin bearer tokens against the configured credentials
+type Authenticator struct {
+	credentials []credential
+}
+
+// NewAuthenticator parses the "actor@tenant:token" entries from cfg. Every
+// credential is bound to one tenant slug, or to "*" for a super-admin.
+func NewAuthenticator(cfg config.AuthConfig) *Authenticator {
+	a := &Authenticator{}
+
+	for _, entry := range cfg.AdminTokens {
+		identity, token, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		actor, tenant, bound := strings.Cut(identity, "@")
+		if !ok || !bound || actor == "" || tenant == "" || token == "" {
+			log.Warn().Msg("Ignoring malformed admin token, expected actor@tenant:token or actor@*:token")
+			continue
+		}
+		a.credentials = append(a.credentials, credential{
+			principal: Principal{Actor: actor, Tenant: strings.ToLower(tenant)},
+			hash:      sha256.Sum256([]byte(token)),
+		})
+	}
+
+	if len(a.credentials) == 0 {
+		log.Warn().Msg("No admin tokens configured, admin routes are disabled")
+	}
+
+	return a
+}
+
+// Authenticate returns the principal owning token. Every credential is
+// compared in constant time so response timing does not reveal partial
+// matches.
+func (a *Authenticator) Authenticate(token string) (Principal, bool) {
+	if token == "" {
+		return Principal{}, false
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	var principal Principal
+	found := false
+	for _, cred := range a.credentials {
+		if subtle.ConstantTimeCompare(hash[:], cred.hash[:]) == 1 {
+			principal = cred.principal
+			found = true
+		}
+	}
+
+	return principal, found
+}
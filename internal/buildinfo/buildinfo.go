@@ -0,0 +1,86 @@
+// Package buildinfo describes the running binary. Release builds set the
+// variables below with
+//
+//	-ldflags "-X portfolio-backend/internal/buildinfo.Version=1.2.0 ..."
+//
+// (see scripts/build.sh). Without them, the module version and VCS stamp
+// that Go embeds in binaries built from a checkout are used.
+package buildinfo
+
+import (
+	"encoding/xml"
+	"runtime"
+	"runtime/debug"
+	"sync"
+)
+
+// Set at link time
+var (
+	Version   string
+	Commit    string
+	BuildTime string
+)
+
+// Info is the build metadata served at /v1/version
+type Info struct {
+	XMLName   xml.Name `json:"-" xml:"build"`
+	Version   string   `json:"version" xml:"version"`
+	Commit    string   `json:"commit" xml:"commit"`
+	BuildTime string   `json:"build_time" xml:"build_time"`
+	GoVersion string   `json:"go_version" xml:"go_version"`
+	// Modified reports uncommitted changes in a checkout build
+	Modified bool `json:"modified,omitempty" xml:"modified,omitempty"`
+}
+
+var (
+	once sync.Once
+	info Info
+)
+
+// Get returns the build metadata
+func Get() Info {
+	once.Do(load)
+	return info
+}
+
+func load() {
+	info = Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		if info.Version == "" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			info.Version = bi.Main.Version
+		}
+
+		vcs := info.Commit == ""
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if vcs {
+					info.Commit = setting.Value
+				}
+			case "vcs.time":
+				// The commit time stands in for the build time
+				if info.BuildTime == "" {
+					info.BuildTime = setting.Value
+				}
+			case "vcs.modified":
+				info.Modified = vcs && setting.Value == "true"
+			}
+		}
+	}
+
+	if info.Version == "" {
+		info.Version = "dev"
+	}
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = "unknown"
+	}
+}
@@ -8,11 +8,27 @@ import (
 )
 
 type Config struct {
-	Server    ServerConfig    `mapstructure:"server"`
-	Database  DatabaseConfig  `mapstructure:"database"`
-	CORS      CORSConfig      `mapstructure:"cors"`
-	Logging   LoggingConfig   `mapstructure:"logging"`
-	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+	Server        ServerConfig        `mapstructure:"server"`
+	Database      DatabaseConfig      `mapstructure:"database"`
+	CORS          CORSConfig          `mapstructure:"cors"`
+	Logging       LoggingConfig       `mapstructure:"logging"`
+	RateLimit     RateLimitConfig     `mapstructure:"rate_limit"`
+	API           APIConfig           `mapstructure:"api"`
+	Tenancy       TenancyConfig       `mapstructure:"tenancy"`
+	I18n          I18nConfig          `mapstructure:"i18n"`
+	Mail          MailConfig          `mapstructure:"mail"`
+	Contact       ContactConfig       `mapstructure:"contact"`
+	Auth          AuthConfig          `mapstructure:"auth"`
+	Privacy       PrivacyConfig       `mapstructure:"privacy"`
+	Revisions     RevisionsConfig     `mapstructure:"revisions"`
+	Publishing    PublishingConfig    `mapstructure:"publishing"`
+	Trash         TrashConfig         `mapstructure:"trash"`
+	Storage       StorageConfig       `mapstructure:"storage"`
+	Uploads       UploadsConfig       `mapstructure:"uploads"`
+	OGImage       OGImageConfig       `mapstructure:"og_image"`
+	AdminServer   AdminServerConfig   `mapstructure:"admin_server"`
+	Tracing       TracingConfig       `mapstructure:"tracing"`
+	Health        HealthConfig        `mapstructure:"health"`
 }
 
 type ServerConfig struct {
@@ -20,17 +36,29 @@ type ServerConfig struct {
 	Port         int           `mapstructure:"port"`
 	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
 	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	// TrustedProxies lists the addresses or CIDRs whose X-Forwarded-For and
+	// X-Real-IP headers are believed; with none, the peer address is the client
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 type DatabaseConfig struct {
 	Host            string        `mapstructure:"host"`
 	Port            int           `mapstructure:"port"`
 	User            string        `mapstructure:"user"`
-	Password        string        `mapstructure:"password"`
+	Password        string        `mapstructure:"password" redact:"true"`
 	Database        string        `mapstructure:"database"`
 	MaxOpenConns    int           `mapstructure:"max_open_conns"`
 	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+	// SlowQueryThreshold logs statements that take longer; 0 disables it
+	SlowQueryThreshold time.Duration `mapstructure:"slow_query_threshold"`
+
+	// Deadlines of repository calls by kind (0 disables). Calls that run
+	// out fail with 503 Service Unavailable and a Retry-After of RetryAfter.
+	ReadTimeout      time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout     time.Duration `mapstructure:"write_timeout"`
+	AggregateTimeout time.Duration `mapstructure:"aggregate_timeout"`
+	RetryAfter       time.Duration `mapstructure:"retry_after"`
 }
 
 type CORSConfig struct {
@@ -42,6 +70,25 @@ type CORSConfig struct {
 type LoggingConfig struct {
 	Level  string `mapstructure:"level"`
 	Format string `mapstructure:"format"`
+
+	// Redaction of personal data in logs. Names are matched case-insensitively.
+	RedactQueryParams []string `mapstructure:"redact_query_params"`
+	RedactHeaders     []string `mapstructure:"redact_headers"`
+	RedactFields      []string `mapstructure:"redact_fields"`
+	// IPMode is "full", "truncate" (IPv4 /24, IPv6 /48) or "hash"
+	IPMode    string `mapstructure:"ip_mode"`
+	IPHashKey string `mapstructure:"ip_hash_key" redact:"true"`
+
+	// Fraction of requests logged per status class (0-1); slow requests
+	// are always logged
+	Sample2xx float64 `mapstructure:"sample_2xx"`
+	Sample3xx float64 `mapstructure:"sample_3xx"`
+	Sample4xx float64 `mapstructure:"sample_4xx"`
+	Sample5xx float64 `mapstructure:"sample_5xx"`
+
+	// Requests slower than this are logged at warn with headers and query
+	// parameters (0 disables)
+	SlowRequestThreshold time.Duration `mapstructure:"slow_request_threshold"`
 }
 
 type RateLimitConfig struct {
@@ -50,6 +97,120 @@ type RateLimitConfig struct {
 	CleanupInterval   time.Duration `mapstructure:"cleanup_interval"`
 }
 
+type APIConfig struct {
+	ProblemDetails bool `mapstructure:"problem_details"`
+}
+
+type TenancyConfig struct {
+	BaseDomain    string        `mapstructure:"base_domain"`
+	DefaultTenant string        `mapstructure:"default_tenant"`
+	CacheTTL      time.Duration `mapstructure:"cache_ttl"`
+}
+
+type I18nConfig struct {
+	DefaultLocale    string   `mapstructure:"default_locale"`
+	SupportedLocales []string `mapstructure:"supported_locales"`
+}
+
+type MailConfig struct {
+	Driver          string        `mapstructure:"driver"`
+	From            string        `mapstructure:"from"`
+	SMTPHost        string        `mapstructure:"smtp_host"`
+	SMTPPort        int           `mapstructure:"smtp_port"`
+	SMTPUser        string        `mapstructure:"smtp_user"`
+	SMTPPassword    string        `mapstructure:"smtp_password" redact:"true"`
+	LogPath         string        `mapstructure:"log_path"`
+	OutboxInterval  time.Duration `mapstructure:"outbox_interval"`
+	OutboxBatchSize int           `mapstructure:"outbox_batch_size"`
+	MaxAttempts     int           `mapstructure:"max_attempts"`
+	SendTimeout     time.Duration `mapstructure:"send_timeout"`
+}
+
+type ContactConfig struct {
+	FormSecret    string        `mapstructure:"form_secret" redact:"true"`
+	MinSubmitTime time.Duration `mapstructure:"min_submit_time"`
+	TokenTTL      time.Duration `mapstructure:"token_ttl"`
+	MaxLinks      int           `mapstructure:"max_links"`
+	QuotaPerIP    int           `mapstructure:"quota_per_ip"`
+	QuotaPeriod   time.Duration `mapstructure:"quota_period"`
+}
+
+type AuthConfig struct {
+	// AdminTokens holds "actor@tenant:token" entries accepted as bearer tokens
+	// on the admin routes of that tenant, or of every tenant for "*"
+	AdminTokens []string `mapstructure:"admin_tokens" redact:"true"`
+}
+
+// PrivacyConfig holds the visibility of personal fields for anonymous visitors:
+// "public", "obfuscated" or "authenticated" (hidden unless an admin token is sent)
+type PrivacyConfig struct {
+	ProfileEmail    string `mapstructure:"profile_email"`
+	ProfilePhone    string `mapstructure:"profile_phone"`
+	ProfileLinkedIn string `mapstructure:"profile_linkedin"`
+	ProfileLocation string `mapstructure:"profile_location"`
+}
+
+// RevisionsConfig holds the retention of profile and project revisions.
+// A zero value disables the corresponding rule.
+type RevisionsConfig struct {
+	MaxCount int           `mapstructure:"max_count"`
+	MaxAge   time.Duration `mapstructure:"max_age"`
+}
+
+// PublishingConfig holds the schedule for publishing and archiving entries
+type PublishingConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// TrashConfig holds how long soft-deleted entries are kept before they are purged
+type TrashConfig struct {
+	Retention     time.Duration `mapstructure:"retention"`
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
+// StorageConfig selects where uploaded files are stored. Driver "local" keeps
+// them below LocalPath; PublicURL is the prefix of their served URLs.
+type StorageConfig struct {
+	Driver    string `mapstructure:"driver"`
+	LocalPath string `mapstructure:"local_path"`
+	PublicURL string `mapstructure:"public_url"`
+}
+
+// UploadsConfig holds the limits for uploaded images
+type UploadsConfig struct {
+	MaxBytes  int64 `mapstructure:"max_bytes"`
+	MaxPixels int   `mapstructure:"max_pixels"`
+}
+
+// OGImageConfig holds the settings of the share card images
+type OGImageConfig struct {
+	CacheDir string `mapstructure:"cache_dir"`
+}
+
+// AdminServerConfig holds the address of the admin listener serving
+// operational endpoints such as /metrics. Port 0 disables the listener.
+// The /debug diagnostics are only served when Token is set.
+type AdminServerConfig struct {
+	Host  string `mapstructure:"host"`
+	Port  int    `mapstructure:"port"`
+	Token string `mapstructure:"token" redact:"true"`
+}
+
+// TracingConfig selects the OpenTelemetry span exporter: "none", "stdout"
+// or "otlp" (OTLP over HTTP to OTLPEndpoint)
+type TracingConfig struct {
+	Exporter     string  `mapstructure:"exporter"`
+	OTLPEndpoint string  `mapstructure:"otlp_endpoint"`
+	SampleRatio  float64 `mapstructure:"sample_ratio"`
+}
+
+// HealthConfig tunes the probe checks behind /livez, /readyz and /startupz
+type HealthConfig struct {
+	CheckTimeout time.Duration `mapstructure:"check_timeout"`
+	CacheTTL     time.Duration `mapstructure:"cache_ttl"`
+	MaxOutboxLag time.Duration `mapstructure:"max_outbox_lag"`
+}
+
 func Load() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
@@ -83,6 +244,7 @@ func setDefaults() {
 	viper.SetDefault("server.port", 8080)
 	viper.SetDefault("server.read_timeout", "30s")
 	viper.SetDefault("server.write_timeout", "30s")
+	viper.SetDefault("server.trusted_proxies", []string{})
 
 	// Database defaults
 	viper.SetDefault("database.host", "localhost")
@@ -93,26 +255,112 @@ func setDefaults() {
 	viper.SetDefault("database.max_open_conns", 25)
 	viper.SetDefault("database.max_idle_conns", 5)
 	viper.SetDefault("database.conn_max_lifetime", "5m")
+	viper.SetDefault("database.slow_query_threshold", "100ms")
+	viper.SetDefault("database.read_timeout", "3s")
+	viper.SetDefault("database.write_timeout", "5s")
+	viper.SetDefault("database.aggregate_timeout", "10s")
+	viper.SetDefault("database.retry_after", "5s")
 
 	// CORS defaults (secure - no wildcard)
 	viper.SetDefault("cors.allowed_origins", []string{"http://localhost:3000", "http://localhost:5173"})
-	viper.SetDefault("cors.allowed_methods", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"})
+	viper.SetDefault("cors.allowed_methods", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"})
 	viper.SetDefault("cors.allowed_headers", []string{"Content-Type", "Authorization"})
 
 	// Logging defaults
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.format", "json")
+	viper.SetDefault("logging.redact_query_params", []string{"token", "access_token", "key", "api_key", "password", "secret", "email", "phone"})
+	viper.SetDefault("logging.redact_headers", []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"})
+	viper.SetDefault("logging.redact_fields", []string{"email", "phone", "password", "token", "form_token"})
+	viper.SetDefault("logging.ip_mode", "truncate")
+	viper.SetDefault("logging.ip_hash_key", "")
+	viper.SetDefault("logging.sample_2xx", 1.0)
+	viper.SetDefault("logging.sample_3xx", 1.0)
+	viper.SetDefault("logging.sample_4xx", 1.0)
+	viper.SetDefault("logging.sample_5xx", 1.0)
+	viper.SetDefault("logging.slow_request_threshold", "1s")
 
 	// Rate limiting defaults
 	viper.SetDefault("rate_limit.requests_per_second", 10)
 	viper.SetDefault("rate_limit.burst_size", 20)
 	viper.SetDefault("rate_limit.cleanup_interval", "5m")
 
+	// API defaults (problem details only when requested via Accept header)
+	viper.SetDefault("api.problem_details", false)
+
+	// Tenancy defaults (single-tenant deployments serve the default tenant on every host)
+	viper.SetDefault("tenancy.base_domain", "")
+	viper.SetDefault("tenancy.default_tenant", "default")
+	viper.SetDefault("tenancy.cache_ttl", "1m")
+
+	// I18n defaults (base columns are written in the default locale)
+	viper.SetDefault("i18n.default_locale", "en")
+	viper.SetDefault("i18n.supported_locales", []string{"en", "id"})
+
+	// Mail defaults (log driver so local development never sends real mail)
+	viper.SetDefault("mail.driver", "log")
+	viper.SetDefault("mail.from", "portfolio@localhost")
+	viper.SetDefault("mail.smtp_host", "localhost")
+	viper.SetDefault("mail.smtp_port", 587)
+	viper.SetDefault("mail.smtp_user", "")
+	viper.SetDefault("mail.smtp_password", "")
+	viper.SetDefault("mail.log_path", "")
+	viper.SetDefault("mail.outbox_interval", "10s")
+	viper.SetDefault("mail.outbox_batch_size", 10)
+	viper.SetDefault("mail.max_attempts", 8)
+	viper.SetDefault("mail.send_timeout", "30s")
+
+	// Contact form defaults
+	viper.SetDefault("contact.form_secret", "")
+	viper.SetDefault("contact.min_submit_time", "3s")
+	viper.SetDefault("contact.token_ttl", "2h")
+	viper.SetDefault("contact.max_links", 2)
+	viper.SetDefault("contact.quota_per_ip", 5)
+	viper.SetDefault("contact.quota_period", "1h")
+
+	// Auth defaults (admin routes reject every request until tokens are configured)
+	viper.SetDefault("auth.admin_tokens", []string{})
+
+	// Privacy defaults (contact details are not handed to scrapers verbatim)
+	viper.SetDefault("privacy.profile_email", "obfuscated")
+	viper.SetDefault("privacy.profile_phone", "obfuscated")
+	viper.SetDefault("privacy.profile_linkedin", "public")
+	viper.SetDefault("privacy.profile_location", "public")
+
+	// Revision retention defaults (50 revisions, at most one year old)
+	viper.SetDefault("revisions.max_count", 50)
+	viper.SetDefault("revisions.max_age", "8760h")
+
+	// Scheduled publication defaults
+	viper.SetDefault("publishing.interval", "1m")
+
+	// Trash retention defaults (purge after 30 days, checked hourly)
+	viper.SetDefault("trash.retention", "720h")
+	viper.SetDefault("trash.purge_interval", "1h")
+
+	// Upload storage defaults (10 MB, 40 megapixels)
+	viper.SetDefault("storage.driver", "local")
+	viper.SetDefault("storage.local_path", "./uploads")
+	viper.SetDefault("storage.public_url", "/media")
+	viper.SetDefault("uploads.max_bytes", 10<<20)
+	viper.SetDefault("uploads.max_pixels", 40_000_000)
+	viper.SetDefault("og_image.cache_dir", "./cache/og")
+	viper.SetDefault("admin_server.host", "127.0.0.1")
+	viper.SetDefault("admin_server.port", 9090)
+	viper.SetDefault("admin_server.token", "")
+	viper.SetDefault("tracing.exporter", "none")
+	viper.SetDefault("tracing.otlp_endpoint", "")
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+	viper.SetDefault("health.check_timeout", "2s")
+	viper.SetDefault("health.cache_ttl", "5s")
+	viper.SetDefault("health.max_outbox_lag", "15m")
+
 	// Bind environment variables
 	_ = viper.BindEnv("server.host", "HOST")
 	_ = viper.BindEnv("server.port", "PORT")
 	_ = viper.BindEnv("server.read_timeout", "READ_TIMEOUT")
 	_ = viper.BindEnv("server.write_timeout", "WRITE_TIMEOUT")
+	_ = viper.BindEnv("server.trusted_proxies", "TRUSTED_PROXIES")
 
 	_ = viper.BindEnv("database.host", "DB_HOST")
 	_ = viper.BindEnv("database.port", "DB_PORT")
@@ -122,6 +370,11 @@ func setDefaults() {
 	_ = viper.BindEnv("database.max_open_conns", "DB_MAX_OPEN_CONNS")
 	_ = viper.BindEnv("database.max_idle_conns", "DB_MAX_IDLE_CONNS")
 	_ = viper.BindEnv("database.conn_max_lifetime", "DB_CONN_MAX_LIFETIME")
+	_ = viper.BindEnv("database.slow_query_threshold", "DB_SLOW_QUERY_THRESHOLD")
+	_ = viper.BindEnv("database.read_timeout", "DB_READ_TIMEOUT")
+	_ = viper.BindEnv("database.write_timeout", "DB_WRITE_TIMEOUT")
+	_ = viper.BindEnv("database.aggregate_timeout", "DB_AGGREGATE_TIMEOUT")
+	_ = viper.BindEnv("database.retry_after", "DB_RETRY_AFTER")
 
 	_ = viper.BindEnv("cors.allowed_origins", "CORS_ALLOWED_ORIGINS")
 	_ = viper.BindEnv("cors.allowed_methods", "CORS_ALLOWED_METHODS")
@@ -129,8 +382,75 @@ func setDefaults() {
 
 	_ = viper.BindEnv("logging.level", "LOG_LEVEL")
 	_ = viper.BindEnv("logging.format", "LOG_FORMAT")
+	_ = viper.BindEnv("logging.redact_query_params", "LOG_REDACT_QUERY_PARAMS")
+	_ = viper.BindEnv("logging.redact_headers", "LOG_REDACT_HEADERS")
+	_ = viper.BindEnv("logging.redact_fields", "LOG_REDACT_FIELDS")
+	_ = viper.BindEnv("logging.ip_mode", "LOG_IP_MODE")
+	_ = viper.BindEnv("logging.ip_hash_key", "LOG_IP_HASH_KEY")
+	_ = viper.BindEnv("logging.sample_2xx", "LOG_SAMPLE_2XX")
+	_ = viper.BindEnv("logging.sample_3xx", "LOG_SAMPLE_3XX")
+	_ = viper.BindEnv("logging.sample_4xx", "LOG_SAMPLE_4XX")
+	_ = viper.BindEnv("logging.sample_5xx", "LOG_SAMPLE_5XX")
+	_ = viper.BindEnv("logging.slow_request_threshold", "LOG_SLOW_REQUEST_THRESHOLD")
 
 	_ = viper.BindEnv("rate_limit.requests_per_second", "RATE_LIMIT_REQUESTS_PER_SECOND")
 	_ = viper.BindEnv("rate_limit.burst_size", "RATE_LIMIT_BURST_SIZE")
 	_ = viper.BindEnv("rate_limit.cleanup_interval", "RATE_LIMIT_CLEANUP_INTERVAL")
-}
\ No newline at end of file
+
+	_ = viper.BindEnv("api.problem_details", "API_PROBLEM_DETAILS")
+
+	_ = viper.BindEnv("tenancy.base_domain", "TENANCY_BASE_DOMAIN")
+	_ = viper.BindEnv("tenancy.default_tenant", "TENANCY_DEFAULT_TENANT")
+	_ = viper.BindEnv("tenancy.cache_ttl", "TENANCY_CACHE_TTL")
+
+	_ = viper.BindEnv("i18n.default_locale", "I18N_DEFAULT_LOCALE")
+	_ = viper.BindEnv("i18n.supported_locales", "I18N_SUPPORTED_LOCALES")
+
+	_ = viper.BindEnv("mail.driver", "MAIL_DRIVER")
+	_ = viper.BindEnv("mail.from", "MAIL_FROM")
+	_ = viper.BindEnv("mail.smtp_host", "SMTP_HOST")
+	_ = viper.BindEnv("mail.smtp_port", "SMTP_PORT")
+	_ = viper.BindEnv("mail.smtp_user", "SMTP_USER")
+	_ = viper.BindEnv("mail.smtp_password", "SMTP_PASSWORD")
+	_ = viper.BindEnv("mail.log_path", "MAIL_LOG_PATH")
+	_ = viper.BindEnv("mail.outbox_interval", "MAIL_OUTBOX_INTERVAL")
+	_ = viper.BindEnv("mail.outbox_batch_size", "MAIL_OUTBOX_BATCH_SIZE")
+	_ = viper.BindEnv("mail.max_attempts", "MAIL_MAX_ATTEMPTS")
+	_ = viper.BindEnv("mail.send_timeout", "MAIL_SEND_TIMEOUT")
+
+	_ = viper.BindEnv("contact.form_secret", "CONTACT_FORM_SECRET")
+	_ = viper.BindEnv("contact.min_submit_time", "CONTACT_MIN_SUBMIT_TIME")
+	_ = viper.BindEnv("contact.token_ttl", "CONTACT_TOKEN_TTL")
+	_ = viper.BindEnv("contact.max_links", "CONTACT_MAX_LINKS")
+	_ = viper.BindEnv("contact.quota_per_ip", "CONTACT_QUOTA_PER_IP")
+	_ = viper.BindEnv("contact.quota_period", "CONTACT_QUOTA_PERIOD")
+
+	_ = viper.BindEnv("auth.admin_tokens", "ADMIN_TOKENS")
+
+	_ = viper.BindEnv("privacy.profile_email", "PRIVACY_PROFILE_EMAIL")
+	_ = viper.BindEnv("privacy.profile_phone", "PRIVACY_PROFILE_PHONE")
+	_ = viper.BindEnv("privacy.profile_linkedin", "PRIVACY_PROFILE_LINKEDIN")
+	_ = viper.BindEnv("privacy.profile_location", "PRIVACY_PROFILE_LOCATION")
+
+	_ = viper.BindEnv("revisions.max_count", "REVISIONS_MAX_COUNT")
+	_ = viper.BindEnv("revisions.max_age", "REVISIONS_MAX_AGE")
+	_ = viper.BindEnv("publishing.interval", "PUBLISHING_INTERVAL")
+	_ = viper.BindEnv("trash.retention", "TRASH_RETENTION")
+	_ = viper.BindEnv("trash.purge_interval", "TRASH_PURGE_INTERVAL")
+
+	_ = viper.BindEnv("storage.driver", "STORAGE_DRIVER")
+	_ = viper.BindEnv("storage.local_path", "STORAGE_LOCAL_PATH")
+	_ = viper.BindEnv("storage.public_url", "STORAGE_PUBLIC_URL")
+	_ = viper.BindEnv("uploads.max_bytes", "UPLOAD_MAX_BYTES")
+	_ = viper.BindEnv("uploads.max_pixels", "UPLOAD_MAX_PIXELS")
+	_ = viper.BindEnv("og_image.cache_dir", "OG_CACHE_DIR")
+	_ = viper.BindEnv("admin_server.host", "ADMIN_SERVER_HOST")
+	_ = viper.BindEnv("admin_server.port", "ADMIN_SERVER_PORT")
+	_ = viper.BindEnv("admin_server.token", "ADMIN_SERVER_TOKEN")
+	_ = viper.BindEnv("tracing.exporter", "TRACING_EXPORTER")
+	_ = viper.BindEnv("tracing.otlp_endpoint", "TRACING_OTLP_ENDPOINT")
+	_ = viper.BindEnv("tracing.sample_ratio", "TRACING_SAMPLE_RATIO")
+	_ = viper.BindEnv("health.check_timeout", "HEALTH_CHECK_TIMEOUT")
+	_ = viper.BindEnv("health.cache_ttl", "HEALTH_CACHE_TTL")
+	_ = viper.BindEnv("health.max_outbox_lag", "HEALTH_MAX_OUTBOX_LAG")
+}
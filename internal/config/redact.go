@@ -0,0 +1,50 @@
+package config
+
+import (
+	"reflect"
+	"time"
+)
+
+// redactedValue replaces secrets in Redacted
+const redactedValue = "[REDACTED]"
+
+// Redacted returns the configuration as nested maps keyed like the YAML
+// file, with the values of fields tagged `redact:"true"` masked. Empty
+// secrets stay empty so a missing secret is still visible.
+func (c *Config) Redacted() map[string]interface{} {
+	return redactStruct(reflect.ValueOf(*c))
+}
+
+func redactStruct(v reflect.Value) map[string]interface{} {
+	out := make(map[string]interface{}, v.NumField())
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Tag.Get("mapstructure")
+		if name == "" {
+			name = field.Name
+		}
+		out[name] = redactValue(v.Field(i), field.Tag.Get("redact") == "true")
+	}
+	return out
+}
+
+func redactValue(v reflect.Value, secret bool) interface{} {
+	if secret {
+		if v.IsZero() || (v.Kind() == reflect.Slice && v.Len() == 0) {
+			return v.Interface()
+		}
+		return redactedValue
+	}
+
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+	if v.Kind() == reflect.Struct {
+		return redactStruct(v)
+	}
+	return v.Interface()
+}
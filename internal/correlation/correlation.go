@@ -0,0 +1,16 @@
+package correlation
+
+import "context"
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the request correlation ID
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the correlation ID stored in ctx, if any
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(contextKey{}).(string)
+	return id, ok && id != ""
+}
@@ -0,0 +1,29 @@
+package correlation
+
+import "github.com/google/uuid"
+
+// MaxLength is the longest inbound correlation ID accepted, matching the
+// correlation_id columns
+const MaxLength = 128
+
+// New returns a time-ordered UUIDv7 correlation ID
+func New() string {
+	return uuid.Must(uuid.NewV7()).String()
+}
+
+// Valid reports whether an inbound correlation ID can be used as is: 1 to
+// MaxLength characters from A-Z, a-z, 0-9 and "-", "_", ".", ":"
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		switch c := id[i]; {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
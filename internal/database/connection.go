@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"time"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-sql-driver/mysql"
 	"github.com/rs/zerolog/log"
 
 	"portfolio-backend/internal/config"
@@ -14,17 +14,25 @@ import (
 
 type DB struct {
 	*sql.DB
+	// Queries aggregates per-statement latency, rows and errors
+	Queries *QueryStats
+
+	timeouts Timeouts
 }
 
 func NewConnection(cfg *config.DatabaseConfig) (*DB, error) {
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local&tls=true",
 		cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.Database)
 
-	db, err := sql.Open("mysql", dsn)
+	connector, err := mysql.MySQLDriver{}.OpenConnector(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
 
+	// Trace and measure every statement run through the pool
+	queries := NewQueryStats(cfg.SlowQueryThreshold)
+	db := sql.OpenDB(&tracedConnector{Connector: connector, stats: queries})
+
 	// Configure connection pool as specified in CLAUDE.md
 	db.SetMaxOpenConns(cfg.MaxOpenConns)
 	db.SetMaxIdleConns(cfg.MaxIdleConns)
@@ -46,9 +54,21 @@ func NewConnection(cfg *config.DatabaseConfig) (*DB, error) {
 		Int("max_open_conns", cfg.MaxOpenConns).
 		Int("max_idle_conns", cfg.MaxIdleConns).
 		Dur("conn_max_lifetime", cfg.ConnMaxLifetime).
+		Dur("slow_query_threshold", cfg.SlowQueryThreshold).
+		Dur("read_timeout", cfg.ReadTimeout).
+		Dur("write_timeout", cfg.WriteTimeout).
+		Dur("aggregate_timeout", cfg.AggregateTimeout).
 		Msg("Database connection established successfully")
 
-	return &DB{DB: db}, nil
+	return &DB{
+		DB:      db,
+		Queries: queries,
+		timeouts: Timeouts{
+			Read:      cfg.ReadTimeout,
+			Write:     cfg.WriteTimeout,
+			Aggregate: cfg.AggregateTimeout,
+		},
+	}, nil
 }
 
 func (db *DB) Close() error {
@@ -0,0 +1,377 @@
+// Package dbtest runs repositories against an in-memory stand-in for MySQL
+// in tests. It understands just enough SQL to answer the repositories'
+// statements: the column list of a SELECT, COUNT(*), and the `column = ?`
+// and `column IN (?, ...)` predicates of the WHERE clause, which filter the
+// seeded rows. Every other predicate is ignored, so a statement that forgets
+// its tenant_id predicate sees the rows of every tenant. Writes are recorded
+// but never change the seeded rows.
+package dbtest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+
+	"portfolio-backend/internal/database"
+)
+
+// Row is a seeded row, keyed by column name
+type Row map[string]driver.Value
+
+// Statement is a recorded statement with its bound arguments
+type Statement struct {
+	Query string
+	Args  []driver.Value
+}
+
+// Table returns the table the statement reads or writes first, or "" for
+// statements over derived tables
+func (s Statement) Table() string {
+	if m := selectPattern.FindStringSubmatch(s.Query); m != nil {
+		return m[2]
+	}
+	if m := writePattern.FindStringSubmatch(s.Query); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// Bound returns the arguments bound to column, through `column = ?` and
+// `column IN (?, ...)` predicates and the placeholders of an INSERT's
+// column list. Other clauses, such as an UPDATE's SET list, are left out
+// unless they use the same forms.
+func (s Statement) Bound(column string) []driver.Value {
+	var bound []driver.Value
+	arg := func(pos int) {
+		if i := strings.Count(s.Query[:pos], "?"); i < len(s.Args) {
+			bound = append(bound, s.Args[i])
+		}
+	}
+
+	for _, m := range equalPattern.FindAllStringSubmatchIndex(s.Query, -1) {
+		if strings.EqualFold(unqualified(s.Query[m[2]:m[3]]), column) {
+			arg(m[1] - 1)
+		}
+	}
+	for _, m := range inPattern.FindAllStringSubmatchIndex(s.Query, -1) {
+		if strings.EqualFold(unqualified(s.Query[m[2]:m[3]]), column) {
+			first := strings.Count(s.Query[:m[0]], "?")
+			for i := 0; i < strings.Count(s.Query[m[0]:m[1]], "?"); i++ {
+				if first+i < len(s.Args) {
+					bound = append(bound, s.Args[first+i])
+				}
+			}
+		}
+	}
+
+	if m := insertPattern.FindStringSubmatchIndex(s.Query); m != nil {
+		names := splitList(s.Query[m[2]:m[3]])
+		for _, tuple := range tuplePattern.FindAllStringSubmatchIndex(s.Query[m[1]:], -1) {
+			offset := m[1] + tuple[2]
+			pos := offset
+			for i, value := range splitList(s.Query[offset : m[1]+tuple[3]]) {
+				trimmed := strings.TrimSpace(value)
+				if i < len(names) && strings.EqualFold(strings.TrimSpace(names[i]), column) && trimmed == "?" {
+					arg(pos + strings.Index(value, "?"))
+				}
+				pos += len(value) + 1
+			}
+		}
+	}
+
+	return bound
+}
+
+// DB holds the seeded tables and the statements run against them
+type DB struct {
+	mu         sync.Mutex
+	tables     map[string][]Row
+	statements []Statement
+}
+
+// New creates an empty database
+func New() *DB {
+	return &DB{tables: make(map[string][]Row)}
+}
+
+// Insert seeds rows into a table
+func (db *DB) Insert(table string, rows ...Row) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.tables[table] = append(db.tables[table], rows...)
+}
+
+// Open returns a database.DB backed by db, without statement deadlines
+func (db *DB) Open() *database.DB {
+	return &database.DB{DB: sql.OpenDB(connector{db: db})}
+}
+
+// Statements returns the statements run since the last Reset
+func (db *DB) Statements() []Statement {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return append([]Statement(nil), db.statements...)
+}
+
+// Reset forgets the recorded statements
+func (db *DB) Reset() {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.statements = nil
+}
+
+var (
+	selectPattern = regexp.MustCompile(`(?is)^SELECT\s+(.+?)\s+FROM\s+(\w*)`)
+	writePattern  = regexp.MustCompile(`(?is)^(?:UPDATE|DELETE\s+FROM|INSERT\s+(?:IGNORE\s+)?INTO)\s+(\w+)`)
+	wherePattern  = regexp.MustCompile(`(?is)\sWHERE\s`)
+	wherePastEnd  = regexp.MustCompile(`(?is)\s(?:ORDER BY|GROUP BY|LIMIT|FOR UPDATE)\s`)
+	equalPattern  = regexp.MustCompile(`(?i)(\w+(?:\.\w+)?)\s*=\s*\?`)
+	inPattern     = regexp.MustCompile(`(?i)(\w+(?:\.\w+)?)\s+IN\s*\(\s*\?(?:\s*,\s*\?)*\s*\)`)
+	aliasPattern  = regexp.MustCompile(`(?is)\sAS\s+(\w+)$`)
+	insertPattern = regexp.MustCompile(`(?is)^INSERT\s+(?:IGNORE\s+)?INTO\s+\w+\s*\(([^)]*)\)\s*VALUES`)
+	tuplePattern  = regexp.MustCompile(`\(((?:[^()]|\([^()]*\))*)\)`)
+)
+
+// record stores a statement and returns the rows of its table matching
+// its WHERE clause
+func (db *DB) record(query string, args []driver.NamedValue) (table string, matches []Row, values []driver.Value) {
+	query = strings.Join(strings.Fields(query), " ")
+	values = make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.statements = append(db.statements, Statement{Query: query, Args: values})
+
+	if m := selectPattern.FindStringSubmatch(query); m != nil {
+		table = m[2]
+	} else if m := writePattern.FindStringSubmatch(query); m != nil {
+		table = m[1]
+	}
+
+	for _, row := range db.tables[table] {
+		if matchesWhere(query, values, row) {
+			matches = append(matches, row)
+		}
+	}
+	return table, matches, values
+}
+
+// matchesWhere applies the equality predicates of the WHERE clause to row.
+// Predicates on columns the row does not have are ignored.
+func matchesWhere(query string, args []driver.Value, row Row) bool {
+	loc := wherePattern.FindStringIndex(query)
+	if loc == nil {
+		return true
+	}
+	start := loc[1]
+	end := len(query)
+	if past := wherePastEnd.FindStringIndex(query[start:]); past != nil {
+		end = start + past[0]
+	}
+	where := query[start:end]
+
+	argIndex := func(pos int) int {
+		return strings.Count(query[:start+pos], "?")
+	}
+
+	for _, m := range equalPattern.FindAllStringSubmatchIndex(where, -1) {
+		column := unqualified(where[m[2]:m[3]])
+		value, ok := row[column]
+		i := argIndex(m[1] - 1)
+		if ok && i < len(args) && !equal(value, args[i]) {
+			return false
+		}
+	}
+
+	for _, m := range inPattern.FindAllStringSubmatchIndex(where, -1) {
+		column := unqualified(where[m[2]:m[3]])
+		value, ok := row[column]
+		if !ok {
+			continue
+		}
+		first := argIndex(m[0])
+		n := strings.Count(where[m[0]:m[1]], "?")
+		found := false
+		for i := first; i < first+n && i < len(args); i++ {
+			if equal(value, args[i]) {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// columns returns the result column names of a SELECT
+func columns(query string) []string {
+	m := selectPattern.FindStringSubmatch(strings.Join(strings.Fields(query), " "))
+	if m == nil {
+		return nil
+	}
+
+	var names []string
+	for _, expr := range splitList(m[1]) {
+		expr = strings.TrimSpace(expr)
+		if alias := aliasPattern.FindStringSubmatch(expr); alias != nil {
+			expr = alias[1]
+		}
+		names = append(names, unqualified(expr))
+	}
+	return names
+}
+
+// splitList splits a comma-separated list outside of parentheses, keeping
+// the whitespace of each item
+func splitList(list string) []string {
+	var items []string
+	depth, last := 0, 0
+	for i, r := range list {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, list[last:i])
+				last = i + 1
+			}
+		}
+	}
+	return append(items, list[last:])
+}
+
+func unqualified(column string) string {
+	if _, name, ok := strings.Cut(column, "."); ok && !strings.Contains(column, "(") {
+		return name
+	}
+	return column
+}
+
+func equal(a, b driver.Value) bool {
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+type connector struct {
+	db *DB
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{db: c.db}, nil
+}
+
+func (c connector) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("dbtest: use DB.Open")
+}
+
+type conn struct {
+	db *DB
+}
+
+func (c *conn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("dbtest: prepared statements are not supported")
+}
+
+func (c *conn) Close() error { return nil }
+
+func (c *conn) Begin() (driver.Tx, error) { return tx{}, nil }
+
+func (c *conn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return tx{}, nil
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	_, matches, _ := c.db.record(query, args)
+
+	names := columns(query)
+	if len(names) == 1 && strings.Contains(names[0], "(") {
+		return &rows{columns: names, values: [][]driver.Value{{aggregate(names[0], matches)}}}, nil
+	}
+
+	result := &rows{columns: names}
+	for _, row := range matches {
+		values := make([]driver.Value, len(names))
+		for i, name := range names {
+			values[i] = row[name]
+		}
+		result.values = append(result.values, values)
+	}
+	return result, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	_, matches, _ := c.db.record(query, args)
+
+	affected := int64(len(matches))
+	if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(query)), "INSERT") {
+		affected = 1
+	}
+	return result{affected: affected}, nil
+}
+
+// aggregate answers a single aggregate column: COUNT(*) counts the
+// matches, COALESCE(...) yields its zero default and anything else NULL
+func aggregate(expr string, matches []Row) driver.Value {
+	upper := strings.ToUpper(expr)
+	switch {
+	case strings.HasPrefix(upper, "COUNT("):
+		return int64(len(matches))
+	case strings.HasPrefix(upper, "COALESCE("):
+		return int64(0)
+	default:
+		return nil
+	}
+}
+
+type tx struct{}
+
+func (tx) Commit() error   { return nil }
+func (tx) Rollback() error { return nil }
+
+type result struct {
+	affected int64
+}
+
+func (r result) LastInsertId() (int64, error) { return 9000, nil }
+func (r result) RowsAffected() (int64, error) { return r.affected, nil }
+
+type rows struct {
+	columns []string
+	values  [][]driver.Value
+	next    int
+}
+
+func (r *rows) Columns() []string { return r.columns }
+
+func (r *rows) Close() error { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
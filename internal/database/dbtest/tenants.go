@@ -0,0 +1,165 @@
+package dbtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Tenant describes one tenant seeded by TwoTenants. Its entity IDs differ
+// from the other tenant's and from both tenant IDs, so a bound argument
+// tells which tenant it belongs to; Marker prefixes every text it owns.
+type Tenant struct {
+	ID     int
+	Slug   string
+	Marker string
+
+	ProfileID       int
+	ExperienceID    int
+	SkillID         int
+	EducationID     int
+	CertificationID int
+	ProjectID       int
+	MediaID         int
+	MessageID       int
+	AuditEventID    int
+	RevisionID      int
+}
+
+// Alpha and Beta are the tenants seeded by TwoTenants
+var (
+	Alpha = Tenant{
+		ID: 101, Slug: "alpha", Marker: "Alpha", ProfileID: 1001,
+		ExperienceID: 1101, SkillID: 1201, EducationID: 1301, CertificationID: 1401,
+		ProjectID: 1501, MediaID: 1601, MessageID: 1701, AuditEventID: 1801, RevisionID: 1901,
+	}
+	Beta = Tenant{
+		ID: 202, Slug: "beta", Marker: "Beta", ProfileID: 2001,
+		ExperienceID: 2101, SkillID: 2201, EducationID: 2301, CertificationID: 2401,
+		ProjectID: 2501, MediaID: 2601, MessageID: 2701, AuditEventID: 2801, RevisionID: 2901,
+	}
+)
+
+// TwoTenants returns a database holding Alpha and Beta with one published
+// entry of every kind each
+func TwoTenants() *DB {
+	db := New()
+	for _, t := range []Tenant{Alpha, Beta} {
+		t.seed(db)
+	}
+	return db
+}
+
+func (t Tenant) seed(db *DB) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	text := func(field string) string {
+		return fmt.Sprintf("%s %s", t.Marker, field)
+	}
+	published := func(row Row) Row {
+		row["tenant_id"] = int64(t.ID)
+		row["created_at"] = at
+		row["updated_at"] = at
+		row["publication_status"] = "published"
+		row["publish_at"] = nil
+		row["unpublish_at"] = nil
+		return row
+	}
+
+	db.Insert("tenants", Row{
+		"id": int64(t.ID), "slug": t.Slug, "name": text("Portfolio"), "custom_domain": nil,
+		"created_at": at, "updated_at": at,
+	})
+	db.Insert("profiles", Row{
+		"id": int64(t.ProfileID), "tenant_id": int64(t.ID), "name": text("Owner"), "title": text("Engineer"),
+		"location": text("City"), "email": t.Slug + "@example.com", "phone": nil, "linkedin": nil,
+		"summary": text("Summary"), "updated_at": at,
+	})
+	db.Insert("experiences", published(Row{
+		"id": int64(t.ExperienceID), "company": text("Company"), "position": text("Position"),
+		"start_date": at, "end_date": nil, "description": text("Experience"), "location": text("City"), "is_current": true,
+	}))
+	db.Insert("skills", published(Row{
+		"id": int64(t.SkillID), "name": text("Skill"), "category": "backend", "level": "expert",
+		"years_of_experience": int64(5), "description": nil,
+	}))
+	db.Insert("education", published(Row{
+		"id": int64(t.EducationID), "institution": text("University"), "degree": text("Degree"),
+		"field": text("Field"), "start_date": at, "end_date": nil, "gpa": nil, "description": nil,
+	}))
+	db.Insert("certifications", published(Row{
+		"id": int64(t.CertificationID), "name": text("Certification"), "issuer": text("Issuer"),
+		"issue_date": at, "expiry_date": nil, "credential_id": nil, "url": nil, "description": nil,
+	}))
+	db.Insert("projects", published(Row{
+		"id": int64(t.ProjectID), "title": text("Project"), "description": text("Project description"),
+		"short_description": nil, "technologies": `["Go"]`, "github_url": nil, "live_url": nil, "image_url": nil,
+		"start_date": at, "end_date": nil, "status": "Completed", "featured": true, "sort_order": int64(1),
+	}))
+	db.Insert("project_media", Row{
+		"id": int64(t.MediaID), "tenant_id": int64(t.ID), "project_id": int64(t.ProjectID),
+		"media_type": "image", "url": "https://example.com/" + t.Slug + ".png", "caption": text("Caption"),
+		"alt_text": text("Image"), "width": nil, "height": nil, "position": int64(1), "created_at": at, "updated_at": at,
+	})
+	db.Insert("messages", Row{
+		"id": int64(t.MessageID), "tenant_id": int64(t.ID), "name": text("Visitor"),
+		"email": "visitor@example.com", "subject": text("Subject"), "body": text("Message body"),
+		"ip_address": "192.0.2.1", "user_agent": "test", "status": "unread", "is_spam": false,
+		"spam_reason": nil, "created_at": at, "updated_at": at,
+	})
+	db.Insert("audit_events", Row{
+		"id": int64(t.AuditEventID), "tenant_id": int64(t.ID), "actor": text("Admin"),
+		"correlation_id": nil, "entity_type": "profile", "entity_id": int64(t.ProfileID), "action": "update",
+		"changes": []byte(`[]`), "created_at": at,
+	})
+	db.Insert("revisions", Row{
+		"id": int64(t.RevisionID), "tenant_id": int64(t.ID), "entity_type": "profile",
+		"entity_id": int64(t.ProfileID), "revision": int64(1), "actor": text("Admin"), "correlation_id": nil,
+		"snapshot": []byte(fmt.Sprintf(`{"name":%q}`, text("Owner"))), "created_at": at,
+	})
+}
+
+// tenantTables hold rows that belong to one tenant
+var tenantTables = map[string]bool{
+	"profiles": true, "experiences": true, "skills": true, "education": true,
+	"certifications": true, "projects": true, "project_media": true, "translations": true,
+	"messages": true, "audit_events": true, "revisions": true, "mail_outbox": true,
+	"used_form_tokens": true,
+}
+
+// AssertScoped fails unless every recorded statement that touches a
+// tenant's table binds tenant_id to want only, and no statement binds the
+// other tenant's ID at all
+func (db *DB) AssertScoped(t testing.TB, want, other Tenant) {
+	t.Helper()
+
+	for _, stmt := range db.Statements() {
+		for _, arg := range stmt.Args {
+			if fmt.Sprint(arg) == fmt.Sprint(other.ID) {
+				t.Errorf("statement binds tenant %s: %s %v", other.Slug, stmt.Query, stmt.Args)
+			}
+		}
+
+		derived := stmt.Table() == "" && strings.Contains(stmt.Query, " UNION ")
+		if !tenantTables[stmt.Table()] && !derived {
+			continue
+		}
+		if stmt.Query == pruneFormTokens {
+			continue
+		}
+
+		tenantIDs := stmt.Bound("tenant_id")
+		if len(tenantIDs) == 0 {
+			t.Errorf("statement is not scoped by tenant_id: %s", stmt.Query)
+		}
+		for _, id := range tenantIDs {
+			if fmt.Sprint(id) != fmt.Sprint(want.ID) {
+				t.Errorf("statement binds tenant_id %v, want %d: %s", id, want.ID, stmt.Query)
+			}
+		}
+	}
+}
+
+// pruneFormTokens deletes the expired nonces of every tenant, which can no
+// longer be replayed
+const pruneFormTokens = "DELETE FROM used_form_tokens WHERE expires_at < NOW() LIMIT 100"
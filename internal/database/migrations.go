@@ -0,0 +1,27 @@
+package database
+
+import (
+	"context"
+	"fmt"
+)
+
+// SchemaVersion is the latest migration in migrations/ that this build
+// depends on. Bump it together with every new migration.
+const SchemaVersion = 10
+
+// MigrationVersion returns the version recorded by golang-migrate and
+// whether the last migration failed halfway
+func (db *DB) MigrationVersion(ctx context.Context) (int, bool, error) {
+	if db.DB == nil {
+		return 0, false, fmt.Errorf("database connection is nil")
+	}
+
+	var version int
+	var dirty bool
+	err := db.DB.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read migration version: %w", err)
+	}
+
+	return version, dirty, nil
+}
@@ -0,0 +1,204 @@
+package database
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// maxTrackedStatements bounds the statistics table. Repositories use a
+// fixed set of statements, so the limit only guards against a bug that
+// embeds values in the SQL text.
+const maxTrackedStatements = 1000
+
+// placeholderList matches the expanded `IN (?,?,?)` lists built by the
+// repositories, so every length is counted as one statement
+var placeholderList = regexp.MustCompile(`\?(\s*,\s*\?)+`)
+
+// QueryStats aggregates latency, row and error counts per statement and
+// logs the statements slower than a threshold. Statements are keyed by
+// their SQL text, which only holds placeholders; argument values are
+// never recorded.
+type QueryStats struct {
+	slowThreshold time.Duration
+
+	mu         sync.Mutex
+	statements map[string]*statementStats
+	untracked  int64
+	since      time.Time
+}
+
+type statementStats struct {
+	operation string
+	calls     int64
+	errors    int64
+	slow      int64
+	rows      int64
+	total     time.Duration
+	max       time.Duration
+}
+
+// StatementStats is the report entry of one statement
+type StatementStats struct {
+	Statement string  `json:"statement"`
+	Operation string  `json:"operation"`
+	Calls     int64   `json:"calls"`
+	Errors    int64   `json:"errors"`
+	Slow      int64   `json:"slow"`
+	Rows      int64   `json:"rows"`
+	TotalMS   float64 `json:"total_ms"`
+	MeanMS    float64 `json:"mean_ms"`
+	MaxMS     float64 `json:"max_ms"`
+}
+
+// QueryReport is the top-N statements report
+type QueryReport struct {
+	Since         time.Time        `json:"since"`
+	SlowThreshold string           `json:"slow_threshold"`
+	Untracked     int64            `json:"untracked"`
+	Statements    []StatementStats `json:"statements"`
+}
+
+// QuerySort orders the statements of a QueryReport
+type QuerySort string
+
+const (
+	SortByTotal  QuerySort = "total"
+	SortByMean   QuerySort = "mean"
+	SortByMax    QuerySort = "max"
+	SortByCalls  QuerySort = "calls"
+	SortByErrors QuerySort = "errors"
+)
+
+// NewQueryStats creates the statistics table. A zero slowThreshold
+// disables slow query logging.
+func NewQueryStats(slowThreshold time.Duration) *QueryStats {
+	return &QueryStats{
+		slowThreshold: slowThreshold,
+		statements:    make(map[string]*statementStats),
+		since:         time.Now(),
+	}
+}
+
+// Top returns the n statements ranked highest by sortBy; n <= 0 returns
+// them all
+func (s *QueryStats) Top(n int, sortBy QuerySort) QueryReport {
+	s.mu.Lock()
+	report := QueryReport{
+		Since:         s.since,
+		SlowThreshold: s.slowThreshold.String(),
+		Untracked:     s.untracked,
+		Statements:    make([]StatementStats, 0, len(s.statements)),
+	}
+	for statement, st := range s.statements {
+		report.Statements = append(report.Statements, StatementStats{
+			Statement: statement,
+			Operation: st.operation,
+			Calls:     st.calls,
+			Errors:    st.errors,
+			Slow:      st.slow,
+			Rows:      st.rows,
+			TotalMS:   milliseconds(st.total),
+			MeanMS:    milliseconds(st.total / time.Duration(st.calls)),
+			MaxMS:     milliseconds(st.max),
+		})
+	}
+	s.mu.Unlock()
+
+	key := func(st StatementStats) float64 {
+		switch sortBy {
+		case SortByMean:
+			return st.MeanMS
+		case SortByMax:
+			return st.MaxMS
+		case SortByCalls:
+			return float64(st.Calls)
+		case SortByErrors:
+			return float64(st.Errors)
+		default:
+			return st.TotalMS
+		}
+	}
+	sort.Slice(report.Statements, func(i, j int) bool {
+		return key(report.Statements[i]) > key(report.Statements[j])
+	})
+
+	if n > 0 && len(report.Statements) > n {
+		report.Statements = report.Statements[:n]
+	}
+	return report
+}
+
+// Reset clears the collected statistics
+func (s *QueryStats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.statements = make(map[string]*statementStats)
+	s.untracked = 0
+	s.since = time.Now()
+}
+
+// observe records one execution of statement and logs it through the
+// request logger when it exceeded the slow threshold. args is the number
+// of bound parameters; their values are left out of the log.
+func (s *QueryStats) observe(ctx context.Context, statement, operation string, duration time.Duration, rows int64, args int, err error) {
+	slow := s.slowThreshold > 0 && duration >= s.slowThreshold
+
+	s.mu.Lock()
+	st, ok := s.statements[statement]
+	if !ok && len(s.statements) < maxTrackedStatements {
+		st = &statementStats{operation: operation}
+		s.statements[statement] = st
+	}
+	if st != nil {
+		st.calls++
+		st.rows += rows
+		st.total += duration
+		st.max = max(st.max, duration)
+		if err != nil {
+			st.errors++
+		}
+		if slow {
+			st.slow++
+		}
+	} else {
+		s.untracked++
+	}
+	s.mu.Unlock()
+
+	if !slow {
+		return
+	}
+
+	logEvent := zerolog.Ctx(ctx).Warn()
+	if err != nil {
+		logEvent = logEvent.Err(err)
+	}
+	logEvent.
+		Str("statement", statement).
+		Str("operation", operation).
+		Dur("duration", duration).
+		Dur("slow_query_threshold", s.slowThreshold).
+		Int64("rows", rows).
+		Int("args", args).
+		Msg("Slow SQL statement")
+}
+
+// normalizeStatement collapses whitespace and placeholder lists, and
+// returns the statement with its upper-cased leading keyword
+func normalizeStatement(query string) (statement, operation string) {
+	statement = strings.Join(strings.Fields(query), " ")
+	statement = placeholderList.ReplaceAllString(statement, "?, ...")
+	operation, _, _ = strings.Cut(statement, " ")
+	return statement, strings.ToUpper(operation)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
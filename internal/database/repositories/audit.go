@@ -0,0 +1,175 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"portfolio-backend/internal/auth"
+	"portfolio-backend/internal/correlation"
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/diff"
+	"portfolio-backend/internal/models"
+)
+
+type AuditRepository interface {
+	ListAuditEvents(ctx context.Context, tenantID int, filter models.AuditFilter) ([]models.AuditEvent, int, error)
+}
+
+type MySQLAuditRepository struct {
+	db *database.DB
+}
+
+func NewAuditRepository(db *database.DB) AuditRepository {
+	return &MySQLAuditRepository{db: db}
+}
+
+// maxCorrelationIDLength matches the correlation_id columns
+const maxCorrelationIDLength = 128
+
+// recordAudit writes an audit event for one entity inside the caller's
+// transaction. before is nil for creations and after is nil for deletions.
+// Updates that change no field are not recorded.
+func recordAudit(ctx context.Context, tx *database.Tx, tenantID int, entityType string, entityID int, action string, before, after interface{}) error {
+	changes, err := diff.Fields(before, after)
+	if err != nil {
+		return fmt.Errorf("failed to diff %s %d: %w", entityType, entityID, err)
+	}
+	if len(changes) == 0 && action == models.AuditActionUpdate {
+		return nil
+	}
+
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit changes: %w", err)
+	}
+
+	actor, correlationID := changeOrigin(ctx)
+
+	query := `
+		INSERT INTO audit_events (tenant_id, actor, correlation_id, entity_type, entity_id, action, changes)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	_, err = tx.ExecContext(ctx, query,
+		tenantID,
+		actor,
+		correlationID,
+		entityType,
+		entityID,
+		action,
+		changesJSON,
+	)
+	if err != nil {
+		return wrapError(err, "failed to insert audit event")
+	}
+
+	return nil
+}
+
+// changeOrigin returns the actor and correlation ID recorded with a change
+func changeOrigin(ctx context.Context) (string, interface{}) {
+	actor, ok := auth.ActorFromContext(ctx)
+	if !ok {
+		actor = models.ActorAnonymous
+	}
+
+	var correlationID interface{}
+	if id, ok := correlation.FromContext(ctx); ok {
+		if len(id) > maxCorrelationIDLength {
+			id = id[:maxCorrelationIDLength]
+		}
+		correlationID = id
+	}
+
+	return actor, correlationID
+}
+
+// ListAuditEvents returns one page of audit events matching filter, newest
+// first, together with the total number of matches
+func (r *MySQLAuditRepository) ListAuditEvents(ctx context.Context, tenantID int, filter models.AuditFilter) ([]models.AuditEvent, int, error) {
+	conditions := []string{"tenant_id = ?"}
+	args := []interface{}{tenantID}
+
+	if filter.EntityType != "" {
+		conditions = append(conditions, "entity_type = ?")
+		args = append(args, filter.EntityType)
+	}
+	if filter.EntityID != nil {
+		conditions = append(conditions, "entity_id = ?")
+		args = append(args, *filter.EntityID)
+	}
+	if filter.Actor != "" {
+		conditions = append(conditions, "actor = ?")
+		args = append(args, filter.Actor)
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, *filter.To)
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.db.QueryRow(ctx, database.Aggregate, `SELECT COUNT(*) FROM audit_events WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, wrapError(err, "failed to count audit events")
+	}
+
+	query := `
+		SELECT id, actor, correlation_id, entity_type, entity_id, action, changes, created_at
+		FROM audit_events 
+		WHERE ` + where + `
+		ORDER BY created_at DESC, id DESC`
+	if filter.PerPage > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, filter.PerPage, filter.Offset())
+	}
+
+	rows, err := r.db.Query(ctx, database.Aggregate, query, args...)
+	if err != nil {
+		return nil, 0, wrapError(err, "failed to query audit events")
+	}
+	defer rows.Close()
+
+	events := []models.AuditEvent{}
+
+	for rows.Next() {
+		var event models.AuditEvent
+		var correlationID sql.NullString
+		var changes []byte
+
+		err := rows.Scan(
+			&event.ID,
+			&event.Actor,
+			&correlationID,
+			&event.EntityType,
+			&event.EntityID,
+			&event.Action,
+			&changes,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan audit event: %w", err)
+		}
+
+		// Handle nullable fields
+		if correlationID.Valid {
+			event.CorrelationID = &correlationID.String
+		}
+		if err := json.Unmarshal(changes, &event.Changes); err != nil {
+			return nil, 0, fmt.Errorf("failed to decode audit changes: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, wrapError(err, "error iterating over audit events")
+	}
+
+	return events, total, nil
+}
@@ -5,30 +5,33 @@ import (
 	"database/sql"
 	"fmt"
 
+	"portfolio-backend/internal/database"
 	"portfolio-backend/internal/models"
 )
 
 type CertificationRepository interface {
-	GetAllCertifications(ctx context.Context) ([]models.Certification, error)
+	GetAllCertifications(ctx context.Context, tenantID int, scope models.Scope) ([]models.Certification, error)
 }
 
 type MySQLCertificationRepository struct {
-	db *sql.DB
+	db *database.DB
 }
 
-func NewCertificationRepository(db *sql.DB) CertificationRepository {
+func NewCertificationRepository(db *database.DB) CertificationRepository {
 	return &MySQLCertificationRepository{db: db}
 }
 
-func (r *MySQLCertificationRepository) GetAllCertifications(ctx context.Context) ([]models.Certification, error) {
+func (r *MySQLCertificationRepository) GetAllCertifications(ctx context.Context, tenantID int, scope models.Scope) ([]models.Certification, error) {
 	query := `
-		SELECT id, name, issuer, issue_date, expiry_date, credential_id, url, description, created_at, updated_at
+		SELECT id, name, issuer, issue_date, expiry_date, credential_id, url, description, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
 		FROM certifications 
+		WHERE tenant_id = ? AND deleted_at IS NULL` + publicationFilter(scope) + `
 		ORDER BY issue_date DESC`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.Query(ctx, database.Read, query, tenantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query certifications: %w", err)
+		return nil, wrapError(err, "failed to query certifications")
 	}
 	defer rows.Close()
 
@@ -50,6 +53,9 @@ func (r *MySQLCertificationRepository) GetAllCertifications(ctx context.Context)
 			&description,
 			&cert.CreatedAt,
 			&cert.UpdatedAt,
+			&cert.PublicationStatus,
+			&cert.PublishAt,
+			&cert.UnpublishAt,
 		)
 
 		if err != nil {
@@ -74,7 +80,7 @@ func (r *MySQLCertificationRepository) GetAllCertifications(ctx context.Context)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating over certifications: %w", err)
+		return nil, wrapError(err, "error iterating over certifications")
 	}
 
 	return certifications, nil
@@ -5,30 +5,33 @@ import (
 	"database/sql"
 	"fmt"
 
+	"portfolio-backend/internal/database"
 	"portfolio-backend/internal/models"
 )
 
 type EducationRepository interface {
-	GetAllEducation(ctx context.Context) ([]models.Education, error)
+	GetAllEducation(ctx context.Context, tenantID int, scope models.Scope) ([]models.Education, error)
 }
 
 type MySQLEducationRepository struct {
-	db *sql.DB
+	db *database.DB
 }
 
-func NewEducationRepository(db *sql.DB) EducationRepository {
+func NewEducationRepository(db *database.DB) EducationRepository {
 	return &MySQLEducationRepository{db: db}
 }
 
-func (r *MySQLEducationRepository) GetAllEducation(ctx context.Context) ([]models.Education, error) {
+func (r *MySQLEducationRepository) GetAllEducation(ctx context.Context, tenantID int, scope models.Scope) ([]models.Education, error) {
 	query := `
-		SELECT id, institution, degree, field, start_date, end_date, gpa, description, created_at, updated_at
+		SELECT id, institution, degree, field, start_date, end_date, gpa, description, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
 		FROM education 
+		WHERE tenant_id = ? AND deleted_at IS NULL` + publicationFilter(scope) + `
 		ORDER BY start_date DESC`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.Query(ctx, database.Read, query, tenantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query education: %w", err)
+		return nil, wrapError(err, "failed to query education")
 	}
 	defer rows.Close()
 
@@ -51,6 +54,9 @@ func (r *MySQLEducationRepository) GetAllEducation(ctx context.Context) ([]model
 			&description,
 			&edu.CreatedAt,
 			&edu.UpdatedAt,
+			&edu.PublicationStatus,
+			&edu.PublishAt,
+			&edu.UnpublishAt,
 		)
 
 		if err != nil {
@@ -72,7 +78,7 @@ func (r *MySQLEducationRepository) GetAllEducation(ctx context.Context) ([]model
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating over education: %w", err)
+		return nil, wrapError(err, "error iterating over education")
 	}
 
 	return educations, nil
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+
+	"portfolio-backend/pkg/apperrors"
+)
+
+// mysqlErrDuplicateEntry is the MySQL error number for unique key violations
+const mysqlErrDuplicateEntry = 1062
+
+// wrapError wraps a database error, classifying connectivity failures and
+// expired database.Timeouts deadlines as apperrors.ErrUnavailable and
+// duplicate keys as apperrors.ErrConflict
+func wrapError(err error, message string) error {
+	var mysqlErr *mysql.MySQLError
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, driver.ErrBadConn),
+		errors.Is(err, mysql.ErrInvalidConn),
+		errors.Is(err, sql.ErrConnDone):
+		return apperrors.Unavailable(err, message)
+	case errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry:
+		return apperrors.Wrap(apperrors.ErrConflict, err, "resource already exists")
+	default:
+		return fmt.Errorf("%s: %w", message, err)
+	}
+}
@@ -3,33 +3,38 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
+	"portfolio-backend/internal/database"
 	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
 )
 
 type ExperienceRepository interface {
-	GetAllExperiences(ctx context.Context) ([]models.Experience, error)
-	GetExperienceByID(ctx context.Context, id int) (*models.Experience, error)
+	GetAllExperiences(ctx context.Context, tenantID int, scope models.Scope) ([]models.Experience, error)
+	GetExperienceByID(ctx context.Context, tenantID int, id int, scope models.Scope) (*models.Experience, error)
 }
 
 type MySQLExperienceRepository struct {
-	db *sql.DB
+	db *database.DB
 }
 
-func NewExperienceRepository(db *sql.DB) ExperienceRepository {
+func NewExperienceRepository(db *database.DB) ExperienceRepository {
 	return &MySQLExperienceRepository{db: db}
 }
 
-func (r *MySQLExperienceRepository) GetAllExperiences(ctx context.Context) ([]models.Experience, error) {
+func (r *MySQLExperienceRepository) GetAllExperiences(ctx context.Context, tenantID int, scope models.Scope) ([]models.Experience, error) {
 	query := `
-		SELECT id, company, position, start_date, end_date, description, location, is_current, created_at, updated_at
+		SELECT id, company, position, start_date, end_date, description, location, is_current, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
 		FROM experiences 
+		WHERE tenant_id = ? AND deleted_at IS NULL` + publicationFilter(scope) + `
 		ORDER BY start_date DESC`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.Query(ctx, database.Read, query, tenantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query experiences: %w", err)
+		return nil, wrapError(err, "failed to query experiences")
 	}
 	defer rows.Close()
 
@@ -50,6 +55,9 @@ func (r *MySQLExperienceRepository) GetAllExperiences(ctx context.Context) ([]mo
 			&exp.IsCurrent,
 			&exp.CreatedAt,
 			&exp.UpdatedAt,
+			&exp.PublicationStatus,
+			&exp.PublishAt,
+			&exp.UnpublishAt,
 		)
 
 		if err != nil {
@@ -65,22 +73,23 @@ func (r *MySQLExperienceRepository) GetAllExperiences(ctx context.Context) ([]mo
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating over experiences: %w", err)
+		return nil, wrapError(err, "error iterating over experiences")
 	}
 
 	return experiences, nil
 }
 
-func (r *MySQLExperienceRepository) GetExperienceByID(ctx context.Context, id int) (*models.Experience, error) {
+func (r *MySQLExperienceRepository) GetExperienceByID(ctx context.Context, tenantID int, id int, scope models.Scope) (*models.Experience, error) {
 	query := `
-		SELECT id, company, position, start_date, end_date, description, location, is_current, created_at, updated_at
+		SELECT id, company, position, start_date, end_date, description, location, is_current, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
 		FROM experiences 
-		WHERE id = ?`
+		WHERE tenant_id = ? AND id = ? AND deleted_at IS NULL` + publicationFilter(scope)
 
 	var exp models.Experience
 	var endDate sql.NullTime
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := r.db.QueryRow(ctx, database.Read, query, tenantID, id).Scan(
 		&exp.ID,
 		&exp.Company,
 		&exp.Position,
@@ -91,13 +100,16 @@ func (r *MySQLExperienceRepository) GetExperienceByID(ctx context.Context, id in
 		&exp.IsCurrent,
 		&exp.CreatedAt,
 		&exp.UpdatedAt,
+		&exp.PublicationStatus,
+		&exp.PublishAt,
+		&exp.UnpublishAt,
 	)
 
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("experience with id %d not found", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NotFound("experience with id %d not found", id)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get experience: %w", err)
+		return nil, wrapError(err, "failed to get experience")
 	}
 
 	// Handle nullable end_date
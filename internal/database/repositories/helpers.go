@@ -0,0 +1,25 @@
+package repositories
+
+import "time"
+
+// rowScanner is implemented by the rows and single-row results of both
+// database.DB and sql.Tx
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// nullableString converts an optional string into a query argument
+func nullableString(value *string) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+// nullableTime converts an optional time into a query argument
+func nullableTime(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,379 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/diff"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+type MessageRepository interface {
+	CreateMessage(ctx context.Context, tenantID int, msg models.Message, notification *models.OutboxEntry) (*models.Message, error)
+	ListMessages(ctx context.Context, tenantID int, filter models.MessageFilter) ([]models.Message, int, error)
+	GetMessageByID(ctx context.Context, tenantID int, id int) (*models.Message, error)
+	UpdateMessages(ctx context.Context, tenantID int, ids []int, status *string, isSpam *bool) (int64, error)
+	DeleteMessages(ctx context.Context, tenantID int, ids []int) (int64, error)
+	ConsumeFormToken(ctx context.Context, tenantID int, nonce []byte, expiresAt time.Time) error
+}
+
+const messageColumns = `id, name, email, subject, body, ip_address, user_agent, status, is_spam, spam_reason, created_at, updated_at`
+
+type MySQLMessageRepository struct {
+	db *database.DB
+}
+
+func NewMessageRepository(db *database.DB) MessageRepository {
+	return &MySQLMessageRepository{db: db}
+}
+
+// ConsumeFormToken records the nonce of a submitted contact form token. It
+// returns apperrors.ErrConflict when the nonce was already used. Expired
+// nonces are pruned on the way, since they can no longer be replayed.
+func (r *MySQLMessageRepository) ConsumeFormToken(ctx context.Context, tenantID int, nonce []byte, expiresAt time.Time) error {
+	if _, err := r.db.Exec(ctx, database.Write, `DELETE FROM used_form_tokens WHERE expires_at < NOW() LIMIT 100`); err != nil {
+		return wrapError(err, "failed to prune used form tokens")
+	}
+
+	_, err := r.db.Exec(ctx, database.Write,
+		`INSERT INTO used_form_tokens (nonce, tenant_id, expires_at) VALUES (?, ?, ?)`,
+		nonce, tenantID, expiresAt)
+	if err != nil {
+		return wrapError(err, "failed to record form token")
+	}
+
+	return nil
+}
+
+// CreateMessage stores a contact message and, when given, its notification
+// email in the outbox within one transaction
+func (r *MySQLMessageRepository) CreateMessage(ctx context.Context, tenantID int, msg models.Message, notification *models.OutboxEntry) (*models.Message, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return nil, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO messages (tenant_id, name, email, subject, body, ip_address, user_agent, status, is_spam, spam_reason)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	var spamReason interface{}
+	if msg.SpamReason != nil {
+		spamReason = *msg.SpamReason
+	}
+
+	result, err := tx.ExecContext(ctx, query,
+		tenantID,
+		msg.Name,
+		msg.Email,
+		msg.Subject,
+		msg.Body,
+		msg.IPAddress,
+		msg.UserAgent,
+		models.MessageStatusUnread,
+		msg.IsSpam,
+		spamReason,
+	)
+	if err != nil {
+		return nil, wrapError(err, "failed to insert message")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message id: %w", err)
+	}
+	msg.ID = int(id)
+	msg.Status = models.MessageStatusUnread
+
+	if notification != nil {
+		notification.MessageID = &msg.ID
+		if err := insertOutboxEntry(ctx, tx, tenantID, *notification); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := recordAudit(ctx, tx, tenantID, models.EntityMessage, msg.ID, models.AuditActionCreate, nil, &msg); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, wrapError(err, "failed to commit message")
+	}
+
+	return &msg, nil
+}
+
+// ListMessages returns one page of messages matching filter, newest first,
+// together with the total number of matches
+func (r *MySQLMessageRepository) ListMessages(ctx context.Context, tenantID int, filter models.MessageFilter) ([]models.Message, int, error) {
+	where, args := messageFilterClause(tenantID, filter)
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM messages WHERE ` + where
+	if err := r.db.QueryRow(ctx, database.Aggregate, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, wrapError(err, "failed to count messages")
+	}
+
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE ` + where + ` ORDER BY created_at DESC, id DESC`
+	if filter.PerPage > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, filter.PerPage, filter.Offset())
+	}
+
+	rows, err := r.db.Query(ctx, database.Aggregate, query, args...)
+	if err != nil {
+		return nil, 0, wrapError(err, "failed to query messages")
+	}
+	defer rows.Close()
+
+	messages := []models.Message{}
+
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan message: %w", err)
+		}
+		messages = append(messages, *msg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, wrapError(err, "error iterating over messages")
+	}
+
+	return messages, total, nil
+}
+
+func (r *MySQLMessageRepository) GetMessageByID(ctx context.Context, tenantID int, id int) (*models.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE tenant_id = ? AND id = ? AND deleted_at IS NULL`
+
+	msg, err := scanMessage(r.db.QueryRow(ctx, database.Read, query, tenantID, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NotFound("message with id %d not found", id)
+		}
+		return nil, wrapError(err, "failed to get message")
+	}
+
+	return msg, nil
+}
+
+// UpdateMessages sets the status and/or spam flag of the given messages and
+// records each change in the audit log within one transaction
+func (r *MySQLMessageRepository) UpdateMessages(ctx context.Context, tenantID int, ids []int, status *string, isSpam *bool) (int64, error) {
+	var sets []string
+	var args []interface{}
+
+	if status != nil {
+		sets = append(sets, "status = ?")
+		args = append(args, *status)
+	}
+	if isSpam != nil {
+		sets = append(sets, "is_spam = ?")
+		args = append(args, *isSpam)
+		if !*isSpam {
+			sets = append(sets, "spam_reason = NULL")
+		}
+	}
+	if len(sets) == 0 {
+		return 0, nil
+	}
+
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return 0, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	before, err := lockMessages(ctx, tx, tenantID, ids)
+	if err != nil {
+		return 0, err
+	}
+	if len(before) == 0 {
+		return 0, nil
+	}
+
+	query := `UPDATE messages SET ` + strings.Join(sets, ", ") +
+		` WHERE tenant_id = ? AND id IN (` + placeholders(len(ids)) + `) AND deleted_at IS NULL`
+	args = append(args, tenantID)
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return 0, wrapError(err, "failed to update messages")
+	}
+
+	after, err := lockMessages(ctx, tx, tenantID, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	var affected int64
+	for i := range after {
+		changes, err := diff.Fields(&before[i], &after[i])
+		if err != nil {
+			return 0, fmt.Errorf("failed to diff message %d: %w", after[i].ID, err)
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		affected++
+
+		if err := recordAudit(ctx, tx, tenantID, models.EntityMessage, after[i].ID, models.AuditActionUpdate, &before[i], &after[i]); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, wrapError(err, "failed to commit message update")
+	}
+
+	return affected, nil
+}
+
+// DeleteMessages moves the given messages to the trash, purges their pending
+// notification emails and records the deletions within one transaction
+func (r *MySQLMessageRepository) DeleteMessages(ctx context.Context, tenantID int, ids []int) (int64, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return 0, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	before, err := lockMessages(ctx, tx, tenantID, ids)
+	if err != nil {
+		return 0, err
+	}
+	if len(before) == 0 {
+		return 0, nil
+	}
+
+	args := []interface{}{tenantID}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	outboxQuery := `DELETE FROM mail_outbox WHERE tenant_id = ? AND message_id IN (` + placeholders(len(ids)) + `) AND status = ?`
+	if _, err := tx.ExecContext(ctx, outboxQuery, append(args, models.OutboxStatusPending)...); err != nil {
+		return 0, wrapError(err, "failed to purge outbox entries")
+	}
+
+	query := `UPDATE messages SET deleted_at = NOW() WHERE tenant_id = ? AND id IN (` + placeholders(len(ids)) + `) AND deleted_at IS NULL`
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, wrapError(err, "failed to delete messages")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	for i := range before {
+		if err := recordAudit(ctx, tx, tenantID, models.EntityMessage, before[i].ID, models.AuditActionDelete, &before[i], nil); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, wrapError(err, "failed to commit message deletion")
+	}
+
+	return affected, nil
+}
+
+// lockMessages reads the given messages ordered by ID, locking them until the transaction ends
+func lockMessages(ctx context.Context, tx *database.Tx, tenantID int, ids []int) ([]models.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE tenant_id = ? AND id IN (` + placeholders(len(ids)) + `) AND deleted_at IS NULL ORDER BY id FOR UPDATE`
+
+	args := []interface{}{tenantID}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, wrapError(err, "failed to lock messages")
+	}
+	defer rows.Close()
+
+	var messages []models.Message
+
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan message: %w", err)
+		}
+		messages = append(messages, *msg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err, "error iterating over messages")
+	}
+
+	return messages, nil
+}
+
+// messageFilterClause builds the WHERE clause and arguments for filter
+func messageFilterClause(tenantID int, filter models.MessageFilter) (string, []interface{}) {
+	conditions := []string{"tenant_id = ?", "deleted_at IS NULL"}
+	args := []interface{}{tenantID}
+
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.Spam != nil {
+		conditions = append(conditions, "is_spam = ?")
+		args = append(args, *filter.Spam)
+	}
+	if filter.Search != "" {
+		pattern := "%" + escapeLike(filter.Search) + "%"
+		conditions = append(conditions, "(name LIKE ? OR email LIKE ? OR subject LIKE ? OR body LIKE ?)")
+		args = append(args, pattern, pattern, pattern, pattern)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// escapeLike escapes the LIKE wildcards in s
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func scanMessage(row rowScanner) (*models.Message, error) {
+	var msg models.Message
+	var spamReason sql.NullString
+
+	err := row.Scan(
+		&msg.ID,
+		&msg.Name,
+		&msg.Email,
+		&msg.Subject,
+		&msg.Body,
+		&msg.IPAddress,
+		&msg.UserAgent,
+		&msg.Status,
+		&msg.IsSpam,
+		&spamReason,
+		&msg.CreatedAt,
+		&msg.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle nullable fields
+	if spamReason.Valid {
+		msg.SpamReason = &spamReason.String
+	}
+
+	return &msg, nil
+}
@@ -0,0 +1,196 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/models"
+)
+
+type OutboxRepository interface {
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]models.OutboxEntry, error)
+	MarkSent(ctx context.Context, id int) error
+	MarkFailed(ctx context.Context, id int, lastError string, nextAttemptAt time.Time, final bool) error
+	OldestPending(ctx context.Context) (*time.Time, error)
+}
+
+type MySQLOutboxRepository struct {
+	db *database.DB
+}
+
+func NewOutboxRepository(db *database.DB) OutboxRepository {
+	return &MySQLOutboxRepository{db: db}
+}
+
+// insertOutboxEntry queues an email inside the caller's transaction
+func insertOutboxEntry(ctx context.Context, tx *database.Tx, tenantID int, entry models.OutboxEntry) error {
+	query := `
+		INSERT INTO mail_outbox (tenant_id, message_id, recipient, reply_to, subject, body, correlation_id, status)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	_, correlationID := changeOrigin(ctx)
+
+	var messageID, replyTo interface{}
+	if entry.MessageID != nil {
+		messageID = *entry.MessageID
+	}
+	if entry.ReplyTo != nil {
+		replyTo = *entry.ReplyTo
+	}
+
+	_, err := tx.ExecContext(ctx, query,
+		tenantID,
+		messageID,
+		entry.Recipient,
+		replyTo,
+		entry.Subject,
+		entry.Body,
+		correlationID,
+		models.OutboxStatusPending,
+	)
+	if err != nil {
+		return wrapError(err, "failed to insert outbox entry")
+	}
+
+	return nil
+}
+
+// ClaimPending locks due entries, counts the attempt and pushes their next
+// attempt out by the lease so concurrent workers skip them
+func (r *MySQLOutboxRepository) ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]models.OutboxEntry, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return nil, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	query := `
+		SELECT id, tenant_id, message_id, recipient, reply_to, subject, body, correlation_id, status, attempts, next_attempt_at
+		FROM mail_outbox 
+		WHERE status = ? AND next_attempt_at <= NOW()
+		ORDER BY next_attempt_at ASC, id ASC
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.QueryContext(ctx, query, models.OutboxStatusPending, limit)
+	if err != nil {
+		return nil, wrapError(err, "failed to query outbox")
+	}
+
+	var entries []models.OutboxEntry
+
+	for rows.Next() {
+		var entry models.OutboxEntry
+		var messageID sql.NullInt64
+		var replyTo, correlationID sql.NullString
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.TenantID,
+			&messageID,
+			&entry.Recipient,
+			&replyTo,
+			&entry.Subject,
+			&entry.Body,
+			&correlationID,
+			&entry.Status,
+			&entry.Attempts,
+			&entry.NextAttemptAt,
+		)
+
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan outbox entry: %w", err)
+		}
+
+		// Handle nullable fields
+		if messageID.Valid {
+			id := int(messageID.Int64)
+			entry.MessageID = &id
+		}
+		if replyTo.Valid {
+			entry.ReplyTo = &replyTo.String
+		}
+		if correlationID.Valid {
+			entry.CorrelationID = &correlationID.String
+		}
+
+		entries = append(entries, entry)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err, "error iterating over outbox")
+	}
+
+	leaseUntil := time.Now().Add(lease)
+	for i := range entries {
+		_, err := tx.ExecContext(ctx,
+			`UPDATE mail_outbox SET attempts = attempts + 1, next_attempt_at = ? WHERE id = ?`,
+			leaseUntil, entries[i].ID)
+		if err != nil {
+			return nil, wrapError(err, "failed to claim outbox entry")
+		}
+		entries[i].Attempts++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, wrapError(err, "failed to commit outbox claim")
+	}
+
+	return entries, nil
+}
+
+func (r *MySQLOutboxRepository) MarkSent(ctx context.Context, id int) error {
+	query := `
+		UPDATE mail_outbox 
+		SET status = ?, sent_at = NOW(), last_error = NULL
+		WHERE id = ?`
+
+	if _, err := r.db.Exec(ctx, database.Write, query, models.OutboxStatusSent, id); err != nil {
+		return wrapError(err, "failed to mark outbox entry as sent")
+	}
+
+	return nil
+}
+
+// MarkFailed records a delivery failure and schedules a retry, or gives up when final is set
+func (r *MySQLOutboxRepository) MarkFailed(ctx context.Context, id int, lastError string, nextAttemptAt time.Time, final bool) error {
+	status := models.OutboxStatusPending
+	if final {
+		status = models.OutboxStatusFailed
+	}
+	lastError = database.TruncateUTF8(lastError, 1000)
+
+	query := `
+		UPDATE mail_outbox 
+		SET status = ?, last_error = ?, next_attempt_at = ?
+		WHERE id = ?`
+
+	if _, err := r.db.Exec(ctx, database.Write, query, status, lastError, nextAttemptAt, id); err != nil {
+		return wrapError(err, "failed to mark outbox entry as failed")
+	}
+
+	return nil
+}
+
+// OldestPending returns when the longest-waiting pending email became due,
+// or nil if none is pending
+func (r *MySQLOutboxRepository) OldestPending(ctx context.Context) (*time.Time, error) {
+	var oldest sql.NullTime
+	err := r.db.QueryRow(ctx, database.Read,
+		`SELECT MIN(next_attempt_at) FROM mail_outbox WHERE status = ?`,
+		models.OutboxStatusPending,
+	).Scan(&oldest)
+	if err != nil {
+		return nil, wrapError(err, "failed to query oldest pending outbox entry")
+	}
+
+	if !oldest.Valid {
+		return nil, nil
+	}
+	return &oldest.Time, nil
+}
@@ -3,67 +3,54 @@ package repositories
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"errors"
 
+	"portfolio-backend/internal/database"
 	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
 )
 
 type ProfileRepository interface {
-	GetProfile(ctx context.Context) (*models.Profile, error)
-	UpdateProfile(ctx context.Context, req models.UpdateProfileRequest) (*models.Profile, error)
+	GetProfile(ctx context.Context, tenantID int) (*models.Profile, error)
+	UpdateProfile(ctx context.Context, tenantID int, req models.UpdateProfileRequest) (*models.Profile, error)
 }
 
 type MySQLProfileRepository struct {
-	db *sql.DB
+	db *database.DB
 }
 
-func NewProfileRepository(db *sql.DB) ProfileRepository {
+func NewProfileRepository(db *database.DB) ProfileRepository {
 	return &MySQLProfileRepository{db: db}
 }
 
-func (r *MySQLProfileRepository) GetProfile(ctx context.Context) (*models.Profile, error) {
-	query := `
-		SELECT name, title, location, email, phone, linkedin, summary, updated_at
-		FROM profiles 
-		LIMIT 1`
+const profileColumns = `id, name, title, location, email, phone, linkedin, summary, updated_at`
 
-	var profile models.Profile
-	var phone, linkedin sql.NullString
+func (r *MySQLProfileRepository) GetProfile(ctx context.Context, tenantID int) (*models.Profile, error) {
+	query := `SELECT ` + profileColumns + ` FROM profiles WHERE tenant_id = ?`
 
-	err := r.db.QueryRowContext(ctx, query).Scan(
-		&profile.Name,
-		&profile.Title,
-		&profile.Location,
-		&profile.Email,
-		&phone,
-		&linkedin,
-		&profile.Summary,
-		&profile.UpdatedAt,
-	)
+	return scanProfile(r.db.QueryRow(ctx, database.Read, query, tenantID))
+}
 
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("profile not found")
-	}
+// UpdateProfile updates the profile and records the change in the audit log
+// and revision history within one transaction
+func (r *MySQLProfileRepository) UpdateProfile(ctx context.Context, tenantID int, req models.UpdateProfileRequest) (*models.Profile, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get profile: %w", err)
+		return nil, wrapError(err, "failed to begin transaction")
 	}
+	defer tx.Rollback()
 
-	// Handle nullable fields
-	if phone.Valid {
-		profile.Phone = &phone.String
-	}
-	if linkedin.Valid {
-		profile.LinkedIn = &linkedin.String
-	}
+	selectQuery := `SELECT ` + profileColumns + ` FROM profiles WHERE tenant_id = ?`
 
-	return &profile, nil
-}
+	before, err := scanProfile(tx.QueryRowContext(ctx, selectQuery+` FOR UPDATE`, tenantID))
+	if err != nil {
+		return nil, err
+	}
 
-func (r *MySQLProfileRepository) UpdateProfile(ctx context.Context, req models.UpdateProfileRequest) (*models.Profile, error) {
 	query := `
 		UPDATE profiles 
 		SET name = ?, title = ?, location = ?, email = ?, phone = ?, linkedin = ?, summary = ?, updated_at = NOW()
-		WHERE id = 1`
+		WHERE tenant_id = ?`
 
 	var phone, linkedin interface{}
 	if req.Phone != nil {
@@ -73,7 +60,7 @@ func (r *MySQLProfileRepository) UpdateProfile(ctx context.Context, req models.U
 		linkedin = *req.LinkedIn
 	}
 
-	result, err := r.db.ExecContext(ctx, query,
+	_, err = tx.ExecContext(ctx, query,
 		req.Name,
 		req.Title,
 		req.Location,
@@ -81,21 +68,62 @@ func (r *MySQLProfileRepository) UpdateProfile(ctx context.Context, req models.U
 		phone,
 		linkedin,
 		req.Summary,
+		tenantID,
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to update profile: %w", err)
+		return nil, wrapError(err, "failed to update profile")
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	after, err := scanProfile(tx.QueryRowContext(ctx, selectQuery, tenantID))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		return nil, err
 	}
 
-	if rowsAffected == 0 {
-		return nil, fmt.Errorf("profile not found or no changes made")
+	if err := recordAudit(ctx, tx, tenantID, models.EntityProfile, after.ID, models.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	if err := recordRevision(ctx, tx, tenantID, models.EntityProfile, after.ID, before, after); err != nil {
+		return nil, err
 	}
 
-	// Return the updated profile
-	return r.GetProfile(ctx)
-}
\ No newline at end of file
+	if err := tx.Commit(); err != nil {
+		return nil, wrapError(err, "failed to commit profile update")
+	}
+
+	return after, nil
+}
+
+func scanProfile(row rowScanner) (*models.Profile, error) {
+	var profile models.Profile
+	var phone, linkedin sql.NullString
+
+	err := row.Scan(
+		&profile.ID,
+		&profile.Name,
+		&profile.Title,
+		&profile.Location,
+		&profile.Email,
+		&phone,
+		&linkedin,
+		&profile.Summary,
+		&profile.UpdatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NotFound("profile not found")
+	}
+	if err != nil {
+		return nil, wrapError(err, "failed to get profile")
+	}
+
+	// Handle nullable fields
+	if phone.Valid {
+		profile.Phone = &phone.String
+	}
+	if linkedin.Valid {
+		profile.LinkedIn = &linkedin.String
+	}
+
+	return &profile, nil
+}
@@ -0,0 +1,304 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+type ProjectMediaRepository interface {
+	ListMedia(ctx context.Context, tenantID int, projectIDs []int) (map[int][]models.ProjectMedia, error)
+	AddMedia(ctx context.Context, tenantID int, projectID int, req models.AddProjectMediaRequest) (*models.ProjectMedia, error)
+	ReorderMedia(ctx context.Context, tenantID int, projectID int, ids []int) ([]models.ProjectMedia, error)
+	DeleteMedia(ctx context.Context, tenantID int, projectID int, mediaID int) error
+}
+
+const projectMediaColumns = `id, project_id, media_type, url, caption, alt_text, width, height, position, created_at, updated_at`
+
+type MySQLProjectMediaRepository struct {
+	db *database.DB
+}
+
+func NewProjectMediaRepository(db *database.DB) ProjectMediaRepository {
+	return &MySQLProjectMediaRepository{db: db}
+}
+
+// ListMedia returns the gallery items of the given projects in display order,
+// keyed by project ID
+func (r *MySQLProjectMediaRepository) ListMedia(ctx context.Context, tenantID int, projectIDs []int) (map[int][]models.ProjectMedia, error) {
+	media := make(map[int][]models.ProjectMedia)
+	if len(projectIDs) == 0 {
+		return media, nil
+	}
+
+	query := `SELECT ` + projectMediaColumns + ` FROM project_media
+		WHERE tenant_id = ? AND project_id IN (` + placeholders(len(projectIDs)) + `)
+		ORDER BY project_id, position, id`
+
+	args := []interface{}{tenantID}
+	for _, id := range projectIDs {
+		args = append(args, id)
+	}
+
+	rows, err := r.db.Query(ctx, database.Read, query, args...)
+	if err != nil {
+		return nil, wrapError(err, "failed to query project media")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanProjectMedia(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan project media: %w", err)
+		}
+		media[item.ProjectID] = append(media[item.ProjectID], *item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err, "error iterating over project media")
+	}
+
+	return media, nil
+}
+
+// AddMedia appends an item to the end of a project's gallery and records the
+// creation in the audit log within one transaction
+func (r *MySQLProjectMediaRepository) AddMedia(ctx context.Context, tenantID int, projectID int, req models.AddProjectMediaRequest) (*models.ProjectMedia, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return nil, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	// Locking the project serializes concurrent appends to its gallery
+	if err := lockProject(ctx, tx, tenantID, projectID); err != nil {
+		return nil, err
+	}
+
+	var position int
+	positionQuery := `SELECT COALESCE(MAX(position), 0) + 1 FROM project_media WHERE tenant_id = ? AND project_id = ?`
+	if err := tx.QueryRowContext(ctx, positionQuery, tenantID, projectID).Scan(&position); err != nil {
+		return nil, wrapError(err, "failed to get next media position")
+	}
+
+	query := `
+		INSERT INTO project_media (tenant_id, project_id, media_type, url, caption, alt_text, width, height, position)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := tx.ExecContext(ctx, query,
+		tenantID,
+		projectID,
+		req.Type,
+		req.URL,
+		req.Caption,
+		req.AltText,
+		req.Width,
+		req.Height,
+		position,
+	)
+	if err != nil {
+		return nil, wrapError(err, "failed to insert project media")
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project media id: %w", err)
+	}
+
+	item, err := scanProjectMedia(tx.QueryRowContext(ctx,
+		`SELECT `+projectMediaColumns+` FROM project_media WHERE tenant_id = ? AND id = ?`, tenantID, id))
+	if err != nil {
+		return nil, wrapError(err, "failed to get project media")
+	}
+
+	if err := recordAudit(ctx, tx, tenantID, models.EntityProjectMedia, item.ID, models.AuditActionCreate, nil, item); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, wrapError(err, "failed to commit project media")
+	}
+
+	return item, nil
+}
+
+// ReorderMedia puts a project's gallery in the order of ids, which must list
+// every item of the gallery exactly once
+func (r *MySQLProjectMediaRepository) ReorderMedia(ctx context.Context, tenantID int, projectID int, ids []int) ([]models.ProjectMedia, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return nil, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if err := lockProject(ctx, tx, tenantID, projectID); err != nil {
+		return nil, err
+	}
+
+	current, err := lockProjectMedia(ctx, tx, tenantID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]models.ProjectMedia, len(current))
+	for _, item := range current {
+		byID[item.ID] = item
+	}
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := byID[id]; !ok || seen[id] {
+			seen = nil
+			break
+		}
+		seen[id] = true
+	}
+	if seen == nil || len(ids) != len(current) {
+		return nil, apperrors.Validation("ids must list every media item of the project exactly once", map[string]interface{}{
+			"ids": fmt.Sprintf("must contain each of the project's %d media item IDs once", len(current)),
+		})
+	}
+
+	query := `UPDATE project_media SET position = ? WHERE tenant_id = ? AND id = ?`
+	reordered := make([]models.ProjectMedia, 0, len(ids))
+	for i, id := range ids {
+		before := byID[id]
+		after := before
+		after.Position = i + 1
+
+		if after.Position != before.Position {
+			if _, err := tx.ExecContext(ctx, query, after.Position, tenantID, id); err != nil {
+				return nil, wrapError(err, "failed to reorder project media")
+			}
+			if err := recordAudit(ctx, tx, tenantID, models.EntityProjectMedia, id, models.AuditActionUpdate, before, after); err != nil {
+				return nil, err
+			}
+		}
+		reordered = append(reordered, after)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, wrapError(err, "failed to commit project media order")
+	}
+
+	return reordered, nil
+}
+
+// DeleteMedia removes an item from a project's gallery and records the
+// deletion in the audit log within one transaction
+func (r *MySQLProjectMediaRepository) DeleteMedia(ctx context.Context, tenantID int, projectID int, mediaID int) error {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if err := lockProject(ctx, tx, tenantID, projectID); err != nil {
+		return err
+	}
+
+	query := `SELECT ` + projectMediaColumns + ` FROM project_media WHERE tenant_id = ? AND project_id = ? AND id = ? FOR UPDATE`
+	before, err := scanProjectMedia(tx.QueryRowContext(ctx, query, tenantID, projectID, mediaID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.NotFound("media item with id %d not found", mediaID)
+	}
+	if err != nil {
+		return wrapError(err, "failed to get project media")
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM project_media WHERE tenant_id = ? AND id = ?`, tenantID, mediaID); err != nil {
+		return wrapError(err, "failed to delete project media")
+	}
+
+	if err := recordAudit(ctx, tx, tenantID, models.EntityProjectMedia, mediaID, models.AuditActionDelete, before, nil); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return wrapError(err, "failed to commit project media deletion")
+	}
+
+	return nil
+}
+
+// lockProject locks a project that is not in the trash
+func lockProject(ctx context.Context, tx *database.Tx, tenantID int, projectID int) error {
+	var id int
+	query := `SELECT id FROM projects WHERE tenant_id = ? AND id = ? AND deleted_at IS NULL FOR UPDATE`
+	err := tx.QueryRowContext(ctx, query, tenantID, projectID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrors.NotFound("project with id %d not found", projectID)
+	}
+	if err != nil {
+		return wrapError(err, "failed to get project")
+	}
+	return nil
+}
+
+// lockProjectMedia locks and returns the gallery items of a project
+func lockProjectMedia(ctx context.Context, tx *database.Tx, tenantID int, projectID int) ([]models.ProjectMedia, error) {
+	query := `SELECT ` + projectMediaColumns + ` FROM project_media WHERE tenant_id = ? AND project_id = ? ORDER BY position, id FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, query, tenantID, projectID)
+	if err != nil {
+		return nil, wrapError(err, "failed to lock project media")
+	}
+	defer rows.Close()
+
+	var media []models.ProjectMedia
+	for rows.Next() {
+		item, err := scanProjectMedia(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan project media: %w", err)
+		}
+		media = append(media, *item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err, "error iterating over project media")
+	}
+
+	return media, nil
+}
+
+func scanProjectMedia(row rowScanner) (*models.ProjectMedia, error) {
+	var item models.ProjectMedia
+	var caption sql.NullString
+	var width, height sql.NullInt64
+
+	err := row.Scan(
+		&item.ID,
+		&item.ProjectID,
+		&item.Type,
+		&item.URL,
+		&caption,
+		&item.AltText,
+		&width,
+		&height,
+		&item.Position,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle nullable fields
+	if caption.Valid {
+		item.Caption = &caption.String
+	}
+	if width.Valid {
+		w := int(width.Int64)
+		item.Width = &w
+	}
+	if height.Valid {
+		h := int(height.Int64)
+		item.Height = &h
+	}
+
+	return &item, nil
+}
@@ -4,35 +4,42 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 
+	"portfolio-backend/internal/database"
 	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
 )
 
 type ProjectRepository interface {
-	GetAllProjects(ctx context.Context) ([]models.Project, error)
-	GetProjectByID(ctx context.Context, id int) (*models.Project, error)
-	GetFeaturedProjects(ctx context.Context) ([]models.Project, error)
+	GetAllProjects(ctx context.Context, tenantID int, scope models.Scope) ([]models.Project, error)
+	GetProjectByID(ctx context.Context, tenantID int, id int, scope models.Scope) (*models.Project, error)
+	GetFeaturedProjects(ctx context.Context, tenantID int, scope models.Scope) ([]models.Project, error)
+	UpdateProject(ctx context.Context, tenantID int, id int, req models.UpdateProjectRequest) (*models.Project, error)
+	UpdateProjectImage(ctx context.Context, tenantID int, id int, imageURL string) (*models.Project, error)
 }
 
 type MySQLProjectRepository struct {
-	db *sql.DB
+	db *database.DB
 }
 
-func NewProjectRepository(db *sql.DB) ProjectRepository {
+func NewProjectRepository(db *database.DB) ProjectRepository {
 	return &MySQLProjectRepository{db: db}
 }
 
-func (r *MySQLProjectRepository) GetAllProjects(ctx context.Context) ([]models.Project, error) {
+func (r *MySQLProjectRepository) GetAllProjects(ctx context.Context, tenantID int, scope models.Scope) ([]models.Project, error) {
 	query := `
 		SELECT id, title, description, short_description, technologies, github_url, live_url, image_url, 
-		       start_date, end_date, status, featured, sort_order, created_at, updated_at
+		       start_date, end_date, status, featured, sort_order, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
 		FROM projects 
+		WHERE tenant_id = ? AND deleted_at IS NULL` + publicationFilter(scope) + `
 		ORDER BY sort_order ASC, start_date DESC`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.Query(ctx, database.Read, query, tenantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query projects: %w", err)
+		return nil, wrapError(err, "failed to query projects")
 	}
 	defer rows.Close()
 
@@ -47,42 +54,44 @@ func (r *MySQLProjectRepository) GetAllProjects(ctx context.Context) ([]models.P
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating over projects: %w", err)
+		return nil, wrapError(err, "error iterating over projects")
 	}
 
 	return projects, nil
 }
 
-func (r *MySQLProjectRepository) GetProjectByID(ctx context.Context, id int) (*models.Project, error) {
+func (r *MySQLProjectRepository) GetProjectByID(ctx context.Context, tenantID int, id int, scope models.Scope) (*models.Project, error) {
 	query := `
 		SELECT id, title, description, short_description, technologies, github_url, live_url, image_url, 
-		       start_date, end_date, status, featured, sort_order, created_at, updated_at
+		       start_date, end_date, status, featured, sort_order, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
 		FROM projects 
-		WHERE id = ?`
+		WHERE tenant_id = ? AND id = ? AND deleted_at IS NULL` + publicationFilter(scope)
 
-	row := r.db.QueryRowContext(ctx, query, id)
+	row := r.db.QueryRow(ctx, database.Read, query, tenantID, id)
 	project, err := r.scanProjectRow(row)
-	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("project with id %d not found", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NotFound("project with id %d not found", id)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, wrapError(err, "failed to get project")
 	}
 
 	return project, nil
 }
 
-func (r *MySQLProjectRepository) GetFeaturedProjects(ctx context.Context) ([]models.Project, error) {
+func (r *MySQLProjectRepository) GetFeaturedProjects(ctx context.Context, tenantID int, scope models.Scope) ([]models.Project, error) {
 	query := `
 		SELECT id, title, description, short_description, technologies, github_url, live_url, image_url, 
-		       start_date, end_date, status, featured, sort_order, created_at, updated_at
+		       start_date, end_date, status, featured, sort_order, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
 		FROM projects 
-		WHERE featured = true
+		WHERE tenant_id = ? AND featured = true AND deleted_at IS NULL` + publicationFilter(scope) + `
 		ORDER BY sort_order ASC, start_date DESC`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.Query(ctx, database.Read, query, tenantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query featured projects: %w", err)
+		return nil, wrapError(err, "failed to query featured projects")
 	}
 	defer rows.Close()
 
@@ -97,14 +106,98 @@ func (r *MySQLProjectRepository) GetFeaturedProjects(ctx context.Context) ([]mod
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating over featured projects: %w", err)
+		return nil, wrapError(err, "error iterating over featured projects")
 	}
 
 	return projects, nil
 }
 
-// scanProject scans a project from sql.Rows
-func (r *MySQLProjectRepository) scanProject(rows *sql.Rows) (*models.Project, error) {
+// UpdateProject updates a project and records the change in the audit log
+// and revision history within one transaction
+func (r *MySQLProjectRepository) UpdateProject(ctx context.Context, tenantID int, id int, req models.UpdateProjectRequest) (*models.Project, error) {
+	technologiesJSON, err := json.Marshal(req.Technologies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal technologies JSON: %w", err)
+	}
+
+	set := `title = ?, description = ?, short_description = ?, technologies = ?, github_url = ?, live_url = ?, image_url = ?,
+		    start_date = ?, end_date = ?, status = ?, featured = ?, sort_order = ?`
+
+	return r.update(ctx, tenantID, id, set,
+		req.Title,
+		req.Description,
+		nullableString(req.ShortDescription),
+		string(technologiesJSON),
+		nullableString(req.GitHubURL),
+		nullableString(req.LiveURL),
+		nullableString(req.ImageURL),
+		req.StartDate,
+		nullableTime(req.EndDate),
+		req.Status,
+		req.Featured,
+		req.SortOrder,
+	)
+}
+
+// UpdateProjectImage sets the image URL of a project, audited and revisioned like UpdateProject
+func (r *MySQLProjectRepository) UpdateProjectImage(ctx context.Context, tenantID int, id int, imageURL string) (*models.Project, error) {
+	return r.update(ctx, tenantID, id, `image_url = ?`, imageURL)
+}
+
+// update applies the SET clause set to a live project and records the change
+// in the audit log and revision history within one transaction
+func (r *MySQLProjectRepository) update(ctx context.Context, tenantID int, id int, set string, args ...interface{}) (*models.Project, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return nil, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	selectQuery := `
+		SELECT id, title, description, short_description, technologies, github_url, live_url, image_url, 
+		       start_date, end_date, status, featured, sort_order, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
+		FROM projects 
+		WHERE tenant_id = ? AND id = ? AND deleted_at IS NULL`
+
+	before, err := r.scanProjectRow(tx.QueryRowContext(ctx, selectQuery+` FOR UPDATE`, tenantID, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NotFound("project with id %d not found", id)
+	}
+	if err != nil {
+		return nil, wrapError(err, "failed to get project")
+	}
+
+	query := `
+		UPDATE projects 
+		SET ` + set + `, updated_at = NOW()
+		WHERE tenant_id = ? AND id = ?`
+
+	if _, err := tx.ExecContext(ctx, query, append(args, tenantID, id)...); err != nil {
+		return nil, wrapError(err, "failed to update project")
+	}
+
+	after, err := r.scanProjectRow(tx.QueryRowContext(ctx, selectQuery, tenantID, id))
+	if err != nil {
+		return nil, wrapError(err, "failed to get project")
+	}
+
+	if err := recordAudit(ctx, tx, tenantID, models.EntityProject, id, models.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	if err := recordRevision(ctx, tx, tenantID, models.EntityProject, id, before, after); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, wrapError(err, "failed to commit project update")
+	}
+
+	return after, nil
+}
+
+// scanProject scans a project from a result set
+func (r *MySQLProjectRepository) scanProject(rows rowScanner) (*models.Project, error) {
 	var project models.Project
 	var endDate sql.NullTime
 	var shortDescription, githubURL, liveURL, imageURL sql.NullString
@@ -126,6 +219,9 @@ func (r *MySQLProjectRepository) scanProject(rows *sql.Rows) (*models.Project, e
 		&project.SortOrder,
 		&project.CreatedAt,
 		&project.UpdatedAt,
+		&project.PublicationStatus,
+		&project.PublishAt,
+		&project.UnpublishAt,
 	)
 
 	if err != nil {
@@ -135,8 +231,8 @@ func (r *MySQLProjectRepository) scanProject(rows *sql.Rows) (*models.Project, e
 	return r.populateProject(&project, endDate, shortDescription, githubURL, liveURL, imageURL, technologiesJSON)
 }
 
-// scanProjectRow scans a project from sql.Row
-func (r *MySQLProjectRepository) scanProjectRow(row *sql.Row) (*models.Project, error) {
+// scanProjectRow scans a project from a single-row result
+func (r *MySQLProjectRepository) scanProjectRow(row rowScanner) (*models.Project, error) {
 	var project models.Project
 	var endDate sql.NullTime
 	var shortDescription, githubURL, liveURL, imageURL sql.NullString
@@ -158,6 +254,9 @@ func (r *MySQLProjectRepository) scanProjectRow(row *sql.Row) (*models.Project,
 		&project.SortOrder,
 		&project.CreatedAt,
 		&project.UpdatedAt,
+		&project.PublicationStatus,
+		&project.PublishAt,
+		&project.UnpublishAt,
 	)
 
 	if err != nil {
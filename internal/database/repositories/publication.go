@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"portfolio-backend/internal/auth"
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// publicationColumns lists the publication columns shared by all portfolio collections
+const publicationColumns = `publication_status, publish_at, unpublish_at`
+
+// publicationTables maps entity types with a publication state to their table
+var publicationTables = map[string]string{
+	models.EntityExperience:    "experiences",
+	models.EntitySkill:         "skills",
+	models.EntityEducation:     "education",
+	models.EntityCertification: "certifications",
+	models.EntityProject:       "projects",
+}
+
+// publicationFilter returns the condition restricting a query to the given scope
+func publicationFilter(scope models.Scope) string {
+	if scope == models.ScopeAll {
+		return ""
+	}
+	return ` AND publication_status = 'published'
+		AND (publish_at IS NULL OR publish_at <= NOW())
+		AND (unpublish_at IS NULL OR unpublish_at > NOW())`
+}
+
+// publicationDest returns the scan destinations for publicationColumns
+func publicationDest(p *models.Publication) []interface{} {
+	return []interface{}{&p.PublicationStatus, &p.PublishAt, &p.UnpublishAt}
+}
+
+type PublicationRepository interface {
+	SetPublication(ctx context.Context, tenantID int, entityType string, id int, req models.UpdatePublicationRequest) (*models.Publication, error)
+	ApplySchedules(ctx context.Context) ([]int, error)
+}
+
+type MySQLPublicationRepository struct {
+	db *database.DB
+}
+
+func NewPublicationRepository(db *database.DB) PublicationRepository {
+	return &MySQLPublicationRepository{db: db}
+}
+
+// SetPublication changes the publication state of one entry and records the
+// change in the audit log within one transaction
+func (r *MySQLPublicationRepository) SetPublication(ctx context.Context, tenantID int, entityType string, id int, req models.UpdatePublicationRequest) (*models.Publication, error) {
+	table, ok := publicationTables[entityType]
+	if !ok {
+		return nil, fmt.Errorf("entity type %q has no publication state", entityType)
+	}
+
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return nil, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	selectQuery := `SELECT ` + publicationColumns + ` FROM ` + table + ` WHERE tenant_id = ? AND id = ? AND deleted_at IS NULL`
+
+	var before models.Publication
+	err = tx.QueryRowContext(ctx, selectQuery+` FOR UPDATE`, tenantID, id).Scan(publicationDest(&before)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NotFound("%s with id %d not found", entityType, id)
+	}
+	if err != nil {
+		return nil, wrapError(err, "failed to get publication")
+	}
+
+	query := `
+		UPDATE ` + table + `
+		SET publication_status = ?, publish_at = ?, unpublish_at = ?, updated_at = NOW()
+		WHERE tenant_id = ? AND id = ?`
+
+	_, err = tx.ExecContext(ctx, query,
+		req.PublicationStatus,
+		nullableTime(req.PublishAt),
+		nullableTime(req.UnpublishAt),
+		tenantID,
+		id,
+	)
+	if err != nil {
+		return nil, wrapError(err, "failed to update publication")
+	}
+
+	var after models.Publication
+	if err := tx.QueryRowContext(ctx, selectQuery, tenantID, id).Scan(publicationDest(&after)...); err != nil {
+		return nil, wrapError(err, "failed to get publication")
+	}
+
+	if err := recordAudit(ctx, tx, tenantID, entityType, id, models.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, wrapError(err, "failed to commit publication update")
+	}
+
+	return &after, nil
+}
+
+// scheduledEntry is an entry whose publication state is due to change
+type scheduledEntry struct {
+	id       int
+	tenantID int
+	models.Publication
+}
+
+// ApplySchedules publishes drafts whose publish_at has passed and archives
+// published entries whose unpublish_at has passed. Each change is audited as
+// the system actor. It returns the IDs of the tenants whose content changed,
+// including those changed before a later table failed.
+func (r *MySQLPublicationRepository) ApplySchedules(ctx context.Context) ([]int, error) {
+	ctx = auth.NewContext(ctx, models.ActorSystem)
+	now := time.Now()
+
+	var firstErr error
+	tenants := make(map[int]struct{})
+	for entityType, table := range publicationTables {
+		changed, err := r.applyTableSchedules(ctx, entityType, table, now)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		for _, tenantID := range changed {
+			tenants[tenantID] = struct{}{}
+		}
+	}
+
+	tenantIDs := make([]int, 0, len(tenants))
+	for tenantID := range tenants {
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	sort.Ints(tenantIDs)
+
+	return tenantIDs, firstErr
+}
+
+// applyTableSchedules applies due publication changes in one table
+func (r *MySQLPublicationRepository) applyTableSchedules(ctx context.Context, entityType, table string, now time.Time) ([]int, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return nil, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	query := `
+		SELECT id, tenant_id, ` + publicationColumns + `
+		FROM ` + table + `
+		WHERE deleted_at IS NULL
+		  AND ((publication_status = 'draft' AND publish_at IS NOT NULL AND publish_at <= ?)
+		    OR (publication_status = 'published' AND unpublish_at IS NOT NULL AND unpublish_at <= ?))
+		FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, query, now, now)
+	if err != nil {
+		return nil, wrapError(err, "failed to query scheduled "+table)
+	}
+
+	var due []scheduledEntry
+	for rows.Next() {
+		var entry scheduledEntry
+		dest := append([]interface{}{&entry.id, &entry.tenantID}, publicationDest(&entry.Publication)...)
+		if err := rows.Scan(dest...); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan scheduled %s: %w", entityType, err)
+		}
+		due = append(due, entry)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return nil, wrapError(err, "error iterating over scheduled "+table)
+	}
+	rows.Close()
+
+	var tenantIDs []int
+	for _, entry := range due {
+		after := entry.Publication
+		if after.PublicationStatus == models.PublicationDraft {
+			after.PublicationStatus = models.PublicationPublished
+		}
+		if after.UnpublishAt != nil && !after.UnpublishAt.After(now) {
+			after.PublicationStatus = models.PublicationArchived
+		}
+
+		update := `UPDATE ` + table + ` SET publication_status = ?, updated_at = NOW() WHERE tenant_id = ? AND id = ?`
+		if _, err := tx.ExecContext(ctx, update, after.PublicationStatus, entry.tenantID, entry.id); err != nil {
+			return nil, wrapError(err, "failed to update scheduled "+table)
+		}
+		if err := recordAudit(ctx, tx, entry.tenantID, entityType, entry.id, models.AuditActionUpdate, entry.Publication, after); err != nil {
+			return nil, err
+		}
+		tenantIDs = append(tenantIDs, entry.tenantID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, wrapError(err, "failed to commit scheduled publication changes")
+	}
+
+	return tenantIDs, nil
+}
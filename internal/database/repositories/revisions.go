@@ -0,0 +1,233 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/diff"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+type RevisionRepository interface {
+	ListRevisions(ctx context.Context, tenantID int, entityType string, entityID int, opts models.ListOptions) ([]models.Revision, int, error)
+	GetRevision(ctx context.Context, tenantID int, entityType string, entityID int, revision int) (*models.Revision, error)
+	PruneRevisions(ctx context.Context, tenantID int, entityType string, entityID int, keep int, olderThan time.Time) (int64, error)
+}
+
+type MySQLRevisionRepository struct {
+	db *database.DB
+}
+
+func NewRevisionRepository(db *database.DB) RevisionRepository {
+	return &MySQLRevisionRepository{db: db}
+}
+
+// recordRevision snapshots after inside the caller's transaction. The first
+// change of an entity also stores before, so the original content is kept.
+// Updates that change no field are not recorded.
+func recordRevision(ctx context.Context, tx *database.Tx, tenantID int, entityType string, entityID int, before, after interface{}) error {
+	changes, err := diff.Fields(before, after)
+	if err != nil {
+		return fmt.Errorf("failed to diff %s %d: %w", entityType, entityID, err)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var latest int
+	err = tx.QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(revision), 0) FROM revisions WHERE tenant_id = ? AND entity_type = ? AND entity_id = ? FOR UPDATE`,
+		tenantID, entityType, entityID,
+	).Scan(&latest)
+	if err != nil {
+		return wrapError(err, "failed to get latest revision")
+	}
+
+	snapshots := []interface{}{after}
+	if latest == 0 && before != nil {
+		snapshots = []interface{}{before, after}
+	}
+
+	actor, correlationID := changeOrigin(ctx)
+
+	query := `
+		INSERT INTO revisions (tenant_id, entity_type, entity_id, revision, actor, correlation_id, snapshot)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	for _, snapshot := range snapshots {
+		data, err := json.Marshal(snapshot)
+		if err != nil {
+			return fmt.Errorf("failed to encode revision snapshot: %w", err)
+		}
+
+		latest++
+		if _, err := tx.ExecContext(ctx, query, tenantID, entityType, entityID, latest, actor, correlationID, data); err != nil {
+			return wrapError(err, "failed to insert revision")
+		}
+	}
+
+	return nil
+}
+
+// ListRevisions returns one page of revisions of an entity, newest first,
+// without snapshots
+func (r *MySQLRevisionRepository) ListRevisions(ctx context.Context, tenantID int, entityType string, entityID int, opts models.ListOptions) ([]models.Revision, int, error) {
+	var total int
+	err := r.db.QueryRow(ctx, database.Aggregate,
+		`SELECT COUNT(*) FROM revisions WHERE tenant_id = ? AND entity_type = ? AND entity_id = ?`,
+		tenantID, entityType, entityID,
+	).Scan(&total)
+	if err != nil {
+		return nil, 0, wrapError(err, "failed to count revisions")
+	}
+
+	query := `
+		SELECT id, entity_type, entity_id, revision, actor, correlation_id, created_at
+		FROM revisions 
+		WHERE tenant_id = ? AND entity_type = ? AND entity_id = ?
+		ORDER BY revision DESC`
+	args := []interface{}{tenantID, entityType, entityID}
+	if opts.PerPage > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, opts.PerPage, opts.Offset())
+	}
+
+	rows, err := r.db.Query(ctx, database.Aggregate, query, args...)
+	if err != nil {
+		return nil, 0, wrapError(err, "failed to query revisions")
+	}
+	defer rows.Close()
+
+	revisions := []models.Revision{}
+
+	for rows.Next() {
+		var revision models.Revision
+		var correlationID sql.NullString
+
+		err := rows.Scan(
+			&revision.ID,
+			&revision.EntityType,
+			&revision.EntityID,
+			&revision.Revision,
+			&revision.Actor,
+			&correlationID,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan revision: %w", err)
+		}
+
+		// Handle nullable fields
+		if correlationID.Valid {
+			revision.CorrelationID = &correlationID.String
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, wrapError(err, "error iterating over revisions")
+	}
+
+	return revisions, total, nil
+}
+
+// GetRevision returns one revision with its snapshot decoded into the entity model
+func (r *MySQLRevisionRepository) GetRevision(ctx context.Context, tenantID int, entityType string, entityID int, number int) (*models.Revision, error) {
+	query := `
+		SELECT id, entity_type, entity_id, revision, actor, correlation_id, snapshot, created_at
+		FROM revisions 
+		WHERE tenant_id = ? AND entity_type = ? AND entity_id = ? AND revision = ?`
+
+	var revision models.Revision
+	var correlationID sql.NullString
+	var snapshot []byte
+
+	err := r.db.QueryRow(ctx, database.Read, query, tenantID, entityType, entityID, number).Scan(
+		&revision.ID,
+		&revision.EntityType,
+		&revision.EntityID,
+		&revision.Revision,
+		&revision.Actor,
+		&correlationID,
+		&snapshot,
+		&revision.CreatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NotFound("revision %d of %s %d not found", number, entityType, entityID)
+	}
+	if err != nil {
+		return nil, wrapError(err, "failed to get revision")
+	}
+
+	// Handle nullable fields
+	if correlationID.Valid {
+		revision.CorrelationID = &correlationID.String
+	}
+
+	switch entityType {
+	case models.EntityProfile:
+		var profile models.Profile
+		err = json.Unmarshal(snapshot, &profile)
+		revision.Snapshot = &profile
+	case models.EntityProject:
+		var project models.Project
+		err = json.Unmarshal(snapshot, &project)
+		revision.Snapshot = &project
+	default:
+		return nil, fmt.Errorf("unsupported revision entity type %q", entityType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode revision snapshot: %w", err)
+	}
+
+	return &revision, nil
+}
+
+// PruneRevisions deletes revisions of an entity beyond the newest keep and
+// those created before olderThan. The newest revision is always kept.
+// A zero keep or olderThan disables that rule.
+func (r *MySQLRevisionRepository) PruneRevisions(ctx context.Context, tenantID int, entityType string, entityID int, keep int, olderThan time.Time) (int64, error) {
+	var latest int
+	err := r.db.QueryRow(ctx, database.Write,
+		`SELECT COALESCE(MAX(revision), 0) FROM revisions WHERE tenant_id = ? AND entity_type = ? AND entity_id = ?`,
+		tenantID, entityType, entityID,
+	).Scan(&latest)
+	if err != nil {
+		return 0, wrapError(err, "failed to get latest revision")
+	}
+	if latest == 0 {
+		return 0, nil
+	}
+
+	query := `DELETE FROM revisions WHERE tenant_id = ? AND entity_type = ? AND entity_id = ? AND revision < ? AND (FALSE`
+	args := []interface{}{tenantID, entityType, entityID, latest}
+	if keep > 0 {
+		query += ` OR revision <= ?`
+		args = append(args, latest-keep)
+	}
+	if !olderThan.IsZero() {
+		query += ` OR created_at < ?`
+		args = append(args, olderThan)
+	}
+	query += `)`
+
+	result, err := r.db.Exec(ctx, database.Write, query, args...)
+	if err != nil {
+		return 0, wrapError(err, "failed to prune revisions")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return affected, nil
+}
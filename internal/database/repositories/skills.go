@@ -5,31 +5,34 @@ import (
 	"database/sql"
 	"fmt"
 
+	"portfolio-backend/internal/database"
 	"portfolio-backend/internal/models"
 )
 
 type SkillRepository interface {
-	GetAllSkills(ctx context.Context) ([]models.Skill, error)
-	GetSkillsByCategory(ctx context.Context) ([]models.SkillCategory, error)
+	GetAllSkills(ctx context.Context, tenantID int, scope models.Scope) ([]models.Skill, error)
+	GetSkillsByCategory(ctx context.Context, tenantID int, scope models.Scope) ([]models.SkillCategory, error)
 }
 
 type MySQLSkillRepository struct {
-	db *sql.DB
+	db *database.DB
 }
 
-func NewSkillRepository(db *sql.DB) SkillRepository {
+func NewSkillRepository(db *database.DB) SkillRepository {
 	return &MySQLSkillRepository{db: db}
 }
 
-func (r *MySQLSkillRepository) GetAllSkills(ctx context.Context) ([]models.Skill, error) {
+func (r *MySQLSkillRepository) GetAllSkills(ctx context.Context, tenantID int, scope models.Scope) ([]models.Skill, error) {
 	query := `
-		SELECT id, name, category, level, years_of_experience, description, created_at, updated_at
+		SELECT id, name, category, level, years_of_experience, description, created_at, updated_at,
+		       publication_status, publish_at, unpublish_at
 		FROM skills 
+		WHERE tenant_id = ? AND deleted_at IS NULL` + publicationFilter(scope) + `
 		ORDER BY category, name`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.Query(ctx, database.Read, query, tenantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query skills: %w", err)
+		return nil, wrapError(err, "failed to query skills")
 	}
 	defer rows.Close()
 
@@ -49,6 +52,9 @@ func (r *MySQLSkillRepository) GetAllSkills(ctx context.Context) ([]models.Skill
 			&description,
 			&skill.CreatedAt,
 			&skill.UpdatedAt,
+			&skill.PublicationStatus,
+			&skill.PublishAt,
+			&skill.UnpublishAt,
 		)
 
 		if err != nil {
@@ -68,15 +74,15 @@ func (r *MySQLSkillRepository) GetAllSkills(ctx context.Context) ([]models.Skill
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating over skills: %w", err)
+		return nil, wrapError(err, "error iterating over skills")
 	}
 
 	return skills, nil
 }
 
-func (r *MySQLSkillRepository) GetSkillsByCategory(ctx context.Context) ([]models.SkillCategory, error) {
+func (r *MySQLSkillRepository) GetSkillsByCategory(ctx context.Context, tenantID int, scope models.Scope) ([]models.SkillCategory, error) {
 	// First get all skills
-	skills, err := r.GetAllSkills(ctx)
+	skills, err := r.GetAllSkills(ctx, tenantID, scope)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get skills: %w", err)
 	}
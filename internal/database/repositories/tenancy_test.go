@@ -0,0 +1,264 @@
+package repositories_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"portfolio-backend/internal/database/dbtest"
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// assertNotFound fails unless err is apperrors.ErrNotFound
+func assertNotFound(t *testing.T, err error) {
+	t.Helper()
+
+	if !apperrors.IsNotFound(err) {
+		t.Fatalf("err = %v, want not found", err)
+	}
+}
+
+// assertOwnRows fails unless text mentions want's marker and not other's
+func assertOwnRows(t *testing.T, text string, want, other dbtest.Tenant) {
+	t.Helper()
+
+	if !strings.Contains(text, want.Marker) {
+		t.Errorf("%q does not contain the rows of tenant %s", text, want.Slug)
+	}
+	if strings.Contains(text, other.Marker) {
+		t.Errorf("%q contains the rows of tenant %s", text, other.Slug)
+	}
+}
+
+func TestRepositoriesReadOnlyTheirTenant(t *testing.T) {
+	ctx := context.Background()
+	alpha, beta := dbtest.Alpha, dbtest.Beta
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repos repositorySet)
+	}{
+		{"profile", func(t *testing.T, r repositorySet) {
+			profile, err := r.profiles.GetProfile(ctx, beta.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertOwnRows(t, profile.Name, beta, alpha)
+		}},
+		{"projects", func(t *testing.T, r repositorySet) {
+			projects, err := r.projects.GetAllProjects(ctx, beta.ID, models.ScopeAll)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertOwnRows(t, fmt.Sprint(projects), beta, alpha)
+		}},
+		{"other tenant's project", func(t *testing.T, r repositorySet) {
+			_, err := r.projects.GetProjectByID(ctx, beta.ID, alpha.ProjectID, models.ScopeAll)
+			assertNotFound(t, err)
+		}},
+		{"project media", func(t *testing.T, r repositorySet) {
+			media, err := r.media.ListMedia(ctx, beta.ID, []int{alpha.ProjectID, beta.ProjectID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(media[alpha.ProjectID]) != 0 || len(media[beta.ProjectID]) != 1 {
+				t.Errorf("media = %v, want only the media of project %d", media, beta.ProjectID)
+			}
+		}},
+		{"messages", func(t *testing.T, r repositorySet) {
+			messages, total, err := r.messages.ListMessages(ctx, beta.ID, models.MessageFilter{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != 1 {
+				t.Errorf("total = %d, want 1", total)
+			}
+			assertOwnRows(t, fmt.Sprint(messages), beta, alpha)
+		}},
+		{"other tenant's message", func(t *testing.T, r repositorySet) {
+			_, err := r.messages.GetMessageByID(ctx, beta.ID, alpha.MessageID)
+			assertNotFound(t, err)
+		}},
+		{"audit events", func(t *testing.T, r repositorySet) {
+			events, total, err := r.audit.ListAuditEvents(ctx, beta.ID, models.AuditFilter{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != 1 {
+				t.Errorf("total = %d, want 1", total)
+			}
+			assertOwnRows(t, fmt.Sprint(events), beta, alpha)
+		}},
+		{"revisions", func(t *testing.T, r repositorySet) {
+			revisions, _, err := r.revisions.ListRevisions(ctx, beta.ID, models.EntityProfile, beta.ProfileID, models.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertOwnRows(t, fmt.Sprint(revisions), beta, alpha)
+		}},
+		{"other tenant's revisions", func(t *testing.T, r repositorySet) {
+			revisions, total, err := r.revisions.ListRevisions(ctx, beta.ID, models.EntityProfile, alpha.ProfileID, models.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != 0 || len(revisions) != 0 {
+				t.Errorf("revisions = %v, want none", revisions)
+			}
+			_, err = r.revisions.GetRevision(ctx, beta.ID, models.EntityProfile, alpha.ProfileID, 1)
+			assertNotFound(t, err)
+		}},
+		{"trash", func(t *testing.T, r repositorySet) {
+			if _, _, err := r.trash.ListTrash(ctx, beta.ID, models.TrashFilter{}); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbtest.TwoTenants()
+			tt.run(t, newRepositorySet(db))
+			db.AssertScoped(t, beta, alpha)
+		})
+	}
+}
+
+func TestRepositoriesChangeOnlyTheirTenant(t *testing.T) {
+	ctx := context.Background()
+	alpha, beta := dbtest.Alpha, dbtest.Beta
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repos repositorySet)
+	}{
+		{"profile", func(t *testing.T, r repositorySet) {
+			req := models.UpdateProfileRequest{Name: "Beta Renamed", Title: "Title", Location: "City", Email: "beta@example.com"}
+			if _, err := r.profiles.UpdateProfile(ctx, beta.ID, req); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"project", func(t *testing.T, r repositorySet) {
+			req := models.UpdateProjectRequest{Title: "Beta Renamed", Description: "Renamed project", Technologies: []string{"Go"}}
+			if _, err := r.projects.UpdateProject(ctx, beta.ID, beta.ProjectID, req); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"project media", func(t *testing.T, r repositorySet) {
+			if err := r.media.DeleteMedia(ctx, beta.ID, beta.ProjectID, beta.MediaID); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"other tenant's project", func(t *testing.T, r repositorySet) {
+			req := models.UpdateProjectRequest{Title: "Stolen", Description: "Stolen project", Technologies: []string{"Go"}}
+			_, err := r.projects.UpdateProject(ctx, beta.ID, alpha.ProjectID, req)
+			assertNotFound(t, err)
+		}},
+		{"other tenant's project image", func(t *testing.T, r repositorySet) {
+			_, err := r.projects.UpdateProjectImage(ctx, beta.ID, alpha.ProjectID, "https://example.com/stolen.png")
+			assertNotFound(t, err)
+		}},
+		{"other tenant's project media", func(t *testing.T, r repositorySet) {
+			_, err := r.media.AddMedia(ctx, beta.ID, alpha.ProjectID, models.AddProjectMediaRequest{Type: "image", URL: "https://example.com/x.png", AltText: "x"})
+			assertNotFound(t, err)
+			_, err = r.media.ReorderMedia(ctx, beta.ID, alpha.ProjectID, []int{alpha.MediaID})
+			assertNotFound(t, err)
+			err = r.media.DeleteMedia(ctx, beta.ID, alpha.ProjectID, alpha.MediaID)
+			assertNotFound(t, err)
+		}},
+		{"message", func(t *testing.T, r repositorySet) {
+			msg := models.Message{Name: "Visitor", Email: "visitor@example.com", Subject: "Hello", Body: "Hello there"}
+			if _, err := r.messages.CreateMessage(ctx, beta.ID, msg, nil); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"messages", func(t *testing.T, r repositorySet) {
+			// dbtest never applies writes, so only the statements are checked
+			status := models.MessageStatusRead
+			if _, err := r.messages.UpdateMessages(ctx, beta.ID, []int{beta.MessageID}, &status, nil); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := r.messages.DeleteMessages(ctx, beta.ID, []int{beta.MessageID}); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"other tenant's messages", func(t *testing.T, r repositorySet) {
+			status := models.MessageStatusRead
+			n, err := r.messages.UpdateMessages(ctx, beta.ID, []int{alpha.MessageID}, &status, nil)
+			if err != nil || n != 0 {
+				t.Errorf("UpdateMessages = %d, %v, want 0 updated", n, err)
+			}
+			n, err = r.messages.DeleteMessages(ctx, beta.ID, []int{alpha.MessageID})
+			if err != nil || n != 0 {
+				t.Errorf("DeleteMessages = %d, %v, want 0 deleted", n, err)
+			}
+		}},
+		{"form token", func(t *testing.T, r repositorySet) {
+			if err := r.messages.ConsumeFormToken(ctx, beta.ID, []byte("nonce"), time.Now().Add(time.Hour)); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"publication", func(t *testing.T, r repositorySet) {
+			req := models.UpdatePublicationRequest{PublicationStatus: models.PublicationDraft}
+			if _, err := r.publication.SetPublication(ctx, beta.ID, models.EntityProject, beta.ProjectID, req); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"other tenant's publication", func(t *testing.T, r repositorySet) {
+			req := models.UpdatePublicationRequest{PublicationStatus: models.PublicationDraft}
+			_, err := r.publication.SetPublication(ctx, beta.ID, models.EntityProject, alpha.ProjectID, req)
+			assertNotFound(t, err)
+		}},
+		{"trash", func(t *testing.T, r repositorySet) {
+			if err := r.trash.DeleteEntry(ctx, beta.ID, models.EntityProject, beta.ProjectID); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"other tenant's trash", func(t *testing.T, r repositorySet) {
+			assertNotFound(t, r.trash.DeleteEntry(ctx, beta.ID, models.EntityProject, alpha.ProjectID))
+			assertNotFound(t, r.trash.RestoreEntry(ctx, beta.ID, models.EntityProject, alpha.ProjectID))
+			assertNotFound(t, r.trash.PurgeEntry(ctx, beta.ID, models.EntityProject, alpha.ProjectID))
+		}},
+		{"revisions", func(t *testing.T, r repositorySet) {
+			if _, err := r.revisions.PruneRevisions(ctx, beta.ID, models.EntityProfile, beta.ProfileID, 10, time.Now()); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbtest.TwoTenants()
+			tt.run(t, newRepositorySet(db))
+			db.AssertScoped(t, beta, alpha)
+		})
+	}
+}
+
+type repositorySet struct {
+	profiles    repositories.ProfileRepository
+	projects    repositories.ProjectRepository
+	media       repositories.ProjectMediaRepository
+	messages    repositories.MessageRepository
+	audit       repositories.AuditRepository
+	revisions   repositories.RevisionRepository
+	publication repositories.PublicationRepository
+	trash       repositories.TrashRepository
+}
+
+func newRepositorySet(db *dbtest.DB) repositorySet {
+	conn := db.Open()
+	return repositorySet{
+		profiles:    repositories.NewProfileRepository(conn),
+		projects:    repositories.NewProjectRepository(conn),
+		media:       repositories.NewProjectMediaRepository(conn),
+		messages:    repositories.NewMessageRepository(conn),
+		audit:       repositories.NewAuditRepository(conn),
+		revisions:   repositories.NewRevisionRepository(conn),
+		publication: repositories.NewPublicationRepository(conn),
+		trash:       repositories.NewTrashRepository(conn),
+	}
+}
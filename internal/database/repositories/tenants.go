@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+type TenantRepository interface {
+	GetTenantBySlug(ctx context.Context, slug string) (*models.Tenant, error)
+	GetTenantByDomain(ctx context.Context, domain string) (*models.Tenant, error)
+}
+
+type MySQLTenantRepository struct {
+	db *database.DB
+}
+
+func NewTenantRepository(db *database.DB) TenantRepository {
+	return &MySQLTenantRepository{db: db}
+}
+
+func (r *MySQLTenantRepository) GetTenantBySlug(ctx context.Context, slug string) (*models.Tenant, error) {
+	query := `
+		SELECT id, slug, name, custom_domain, created_at, updated_at
+		FROM tenants 
+		WHERE slug = ?`
+
+	tenant, err := r.scanTenant(r.db.QueryRow(ctx, database.Read, query, slug))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NotFound("tenant %q not found", slug)
+	}
+	if err != nil {
+		return nil, wrapError(err, "failed to get tenant")
+	}
+
+	return tenant, nil
+}
+
+func (r *MySQLTenantRepository) GetTenantByDomain(ctx context.Context, domain string) (*models.Tenant, error) {
+	query := `
+		SELECT id, slug, name, custom_domain, created_at, updated_at
+		FROM tenants 
+		WHERE custom_domain = ?`
+
+	tenant, err := r.scanTenant(r.db.QueryRow(ctx, database.Read, query, domain))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NotFound("tenant for domain %q not found", domain)
+	}
+	if err != nil {
+		return nil, wrapError(err, "failed to get tenant")
+	}
+
+	return tenant, nil
+}
+
+// scanTenant scans a tenant from a single-row result
+func (r *MySQLTenantRepository) scanTenant(row rowScanner) (*models.Tenant, error) {
+	var tenant models.Tenant
+	var customDomain sql.NullString
+
+	err := row.Scan(
+		&tenant.ID,
+		&tenant.Slug,
+		&tenant.Name,
+		&customDomain,
+		&tenant.CreatedAt,
+		&tenant.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if customDomain.Valid {
+		tenant.CustomDomain = &customDomain.String
+	}
+
+	return &tenant, nil
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/models"
+)
+
+type TranslationRepository interface {
+	GetTranslations(ctx context.Context, tenantID int, entityType string, entityIDs []int, locales []string) ([]models.Translation, error)
+}
+
+type MySQLTranslationRepository struct {
+	db *database.DB
+}
+
+func NewTranslationRepository(db *database.DB) TranslationRepository {
+	return &MySQLTranslationRepository{db: db}
+}
+
+func (r *MySQLTranslationRepository) GetTranslations(ctx context.Context, tenantID int, entityType string, entityIDs []int, locales []string) ([]models.Translation, error) {
+	if len(entityIDs) == 0 || len(locales) == 0 {
+		return nil, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT entity_type, entity_id, field, locale, value
+		FROM translations 
+		WHERE tenant_id = ? AND entity_type = ? AND entity_id IN (%s) AND locale IN (%s)`,
+		placeholders(len(entityIDs)), placeholders(len(locales)))
+
+	args := make([]interface{}, 0, 2+len(entityIDs)+len(locales))
+	args = append(args, tenantID, entityType)
+	for _, id := range entityIDs {
+		args = append(args, id)
+	}
+	for _, locale := range locales {
+		args = append(args, locale)
+	}
+
+	rows, err := r.db.Query(ctx, database.Read, query, args...)
+	if err != nil {
+		return nil, wrapError(err, "failed to query translations")
+	}
+	defer rows.Close()
+
+	var translations []models.Translation
+
+	for rows.Next() {
+		var t models.Translation
+
+		err := rows.Scan(
+			&t.EntityType,
+			&t.EntityID,
+			&t.Field,
+			&t.Locale,
+			&t.Value,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan translation: %w", err)
+		}
+
+		translations = append(translations, t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, wrapError(err, "error iterating over translations")
+	}
+
+	return translations, nil
+}
+
+// placeholders returns n comma-separated SQL placeholders
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
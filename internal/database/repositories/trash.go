@@ -0,0 +1,330 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"portfolio-backend/internal/auth"
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// trashTable describes a table whose rows are soft-deleted
+type trashTable struct {
+	name  string
+	label string // SQL expression naming a row in the trash listing
+}
+
+// trashTables maps entity types that support soft delete to their table
+var trashTables = map[string]trashTable{
+	models.EntityExperience:    {name: "experiences", label: "CONCAT(position, ' at ', company)"},
+	models.EntitySkill:         {name: "skills", label: "name"},
+	models.EntityEducation:     {name: "education", label: "CONCAT(degree, ', ', institution)"},
+	models.EntityCertification: {name: "certifications", label: "name"},
+	models.EntityProject:       {name: "projects", label: "title"},
+	models.EntityMessage:       {name: "messages", label: "CONCAT(name, ': ', subject)"},
+}
+
+// trashState is the audited state of a soft-deleted row
+type trashState struct {
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+type TrashRepository interface {
+	DeleteEntry(ctx context.Context, tenantID int, entityType string, id int) error
+	ListTrash(ctx context.Context, tenantID int, filter models.TrashFilter) ([]models.TrashItem, int, error)
+	RestoreEntry(ctx context.Context, tenantID int, entityType string, id int) error
+	PurgeEntry(ctx context.Context, tenantID int, entityType string, id int) error
+	PurgeExpired(ctx context.Context, deletedBefore time.Time) (int, error)
+}
+
+type MySQLTrashRepository struct {
+	db *database.DB
+}
+
+func NewTrashRepository(db *database.DB) TrashRepository {
+	return &MySQLTrashRepository{db: db}
+}
+
+// lookupTrashTable returns the table of a soft-deletable entity type
+func lookupTrashTable(entityType string) (trashTable, error) {
+	table, ok := trashTables[entityType]
+	if !ok {
+		return trashTable{}, apperrors.Validation(fmt.Sprintf("unsupported entity type: %q", entityType), map[string]interface{}{
+			"entity_type": "must be one of " + strings.Join(trashEntityTypes(), ", "),
+		})
+	}
+	return table, nil
+}
+
+// trashEntityTypes returns the soft-deletable entity types in a stable order
+func trashEntityTypes() []string {
+	entityTypes := make([]string, 0, len(trashTables))
+	for entityType := range trashTables {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+	return entityTypes
+}
+
+// DeleteEntry moves an entry to the trash and records the deletion in the
+// audit log within one transaction
+func (r *MySQLTrashRepository) DeleteEntry(ctx context.Context, tenantID int, entityType string, id int) error {
+	table, err := lookupTrashTable(entityType)
+	if err != nil {
+		return err
+	}
+
+	return r.changeDeletedAt(ctx, tenantID, entityType, table, id, false, models.AuditActionDelete,
+		`UPDATE `+table.name+` SET deleted_at = NOW() WHERE tenant_id = ? AND id = ?`)
+}
+
+// RestoreEntry takes an entry out of the trash and records the restore in the
+// audit log within one transaction
+func (r *MySQLTrashRepository) RestoreEntry(ctx context.Context, tenantID int, entityType string, id int) error {
+	table, err := lookupTrashTable(entityType)
+	if err != nil {
+		return err
+	}
+
+	return r.changeDeletedAt(ctx, tenantID, entityType, table, id, true, models.AuditActionRestore,
+		`UPDATE `+table.name+` SET deleted_at = NULL WHERE tenant_id = ? AND id = ?`)
+}
+
+// changeDeletedAt locks an entry that is (trashed) or is not (!trashed) in the
+// trash, runs update on it and audits the change of deleted_at
+func (r *MySQLTrashRepository) changeDeletedAt(ctx context.Context, tenantID int, entityType string, table trashTable, id int, trashed bool, action string, update string) error {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	before, err := lockTrashState(ctx, tx, tenantID, entityType, table, id, trashed)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, update, tenantID, id); err != nil {
+		return wrapError(err, "failed to update "+table.name)
+	}
+
+	var after trashState
+	query := `SELECT deleted_at FROM ` + table.name + ` WHERE tenant_id = ? AND id = ?`
+	if err := tx.QueryRowContext(ctx, query, tenantID, id).Scan(&after.DeletedAt); err != nil {
+		return wrapError(err, "failed to get "+entityType)
+	}
+
+	if err := recordAudit(ctx, tx, tenantID, entityType, id, action, before, after); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return wrapError(err, "failed to commit "+action)
+	}
+
+	return nil
+}
+
+// PurgeEntry permanently deletes an entry from the trash together with its
+// translations and revisions
+func (r *MySQLTrashRepository) PurgeEntry(ctx context.Context, tenantID int, entityType string, id int) error {
+	table, err := lookupTrashTable(entityType)
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	before, err := lockTrashState(ctx, tx, tenantID, entityType, table, id, true)
+	if err != nil {
+		return err
+	}
+	if err := purgeRow(ctx, tx, tenantID, entityType, table, id, before); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return wrapError(err, "failed to commit purge")
+	}
+
+	return nil
+}
+
+// PurgeExpired permanently deletes every entry trashed before deletedBefore,
+// auditing each purge as the system actor. It returns the number of purged
+// entries, including those purged before a later table failed.
+func (r *MySQLTrashRepository) PurgeExpired(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ctx = auth.NewContext(ctx, models.ActorSystem)
+
+	var purged int
+	var firstErr error
+	for _, entityType := range trashEntityTypes() {
+		n, err := r.purgeExpiredTable(ctx, entityType, trashTables[entityType], deletedBefore)
+		purged += n
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return purged, firstErr
+}
+
+// purgeExpiredTable purges the expired trash of one table
+func (r *MySQLTrashRepository) purgeExpiredTable(ctx context.Context, entityType string, table trashTable, deletedBefore time.Time) (int, error) {
+	ctx, tx, err := r.db.Begin(ctx, database.Write)
+	if err != nil {
+		return 0, wrapError(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	query := `SELECT id, tenant_id, deleted_at FROM ` + table.name + ` WHERE deleted_at IS NOT NULL AND deleted_at < ? FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, wrapError(err, "failed to query expired "+table.name)
+	}
+
+	type expiredRow struct {
+		id       int
+		tenantID int
+		state    trashState
+	}
+
+	var expired []expiredRow
+	for rows.Next() {
+		var row expiredRow
+		if err := rows.Scan(&row.id, &row.tenantID, &row.state.DeletedAt); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan expired %s: %w", entityType, err)
+		}
+		expired = append(expired, row)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return 0, wrapError(err, "error iterating over expired "+table.name)
+	}
+	rows.Close()
+
+	for _, row := range expired {
+		if err := purgeRow(ctx, tx, row.tenantID, entityType, table, row.id, row.state); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, wrapError(err, "failed to commit purge")
+	}
+
+	return len(expired), nil
+}
+
+// ListTrash returns one page of trashed entries, most recently deleted first,
+// together with the total number of matches
+func (r *MySQLTrashRepository) ListTrash(ctx context.Context, tenantID int, filter models.TrashFilter) ([]models.TrashItem, int, error) {
+	entityTypes := trashEntityTypes()
+	if filter.EntityType != "" {
+		if _, err := lookupTrashTable(filter.EntityType); err != nil {
+			return nil, 0, err
+		}
+		entityTypes = []string{filter.EntityType}
+	}
+
+	var selects []string
+	var args []interface{}
+	for _, entityType := range entityTypes {
+		table := trashTables[entityType]
+		selects = append(selects, `SELECT ? AS entity_type, id AS entity_id, `+table.label+` AS label, deleted_at FROM `+table.name+
+			` WHERE tenant_id = ? AND deleted_at IS NOT NULL`)
+		args = append(args, entityType, tenantID)
+	}
+	union := strings.Join(selects, " UNION ALL ")
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM (` + union + `) AS trash`
+	if err := r.db.QueryRow(ctx, database.Aggregate, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, wrapError(err, "failed to count trash")
+	}
+
+	query := `SELECT entity_type, entity_id, label, deleted_at FROM (` + union + `) AS trash ORDER BY deleted_at DESC, entity_type, entity_id DESC`
+	if filter.PerPage > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, filter.PerPage, filter.Offset())
+	}
+
+	rows, err := r.db.Query(ctx, database.Aggregate, query, args...)
+	if err != nil {
+		return nil, 0, wrapError(err, "failed to query trash")
+	}
+	defer rows.Close()
+
+	items := []models.TrashItem{}
+
+	for rows.Next() {
+		var item models.TrashItem
+		if err := rows.Scan(&item.EntityType, &item.EntityID, &item.Label, &item.DeletedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan trash item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, wrapError(err, "error iterating over trash")
+	}
+
+	return items, total, nil
+}
+
+// lockTrashState locks an entry and returns its deletion state. It fails with
+// NotFound unless the entry is in the trash (trashed) or live (!trashed).
+func lockTrashState(ctx context.Context, tx *database.Tx, tenantID int, entityType string, table trashTable, id int, trashed bool) (trashState, error) {
+	condition := `deleted_at IS NULL`
+	if trashed {
+		condition = `deleted_at IS NOT NULL`
+	}
+	query := `SELECT deleted_at FROM ` + table.name + ` WHERE tenant_id = ? AND id = ? AND ` + condition + ` FOR UPDATE`
+
+	var state trashState
+	err := tx.QueryRowContext(ctx, query, tenantID, id).Scan(&state.DeletedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		if trashed {
+			return state, apperrors.NotFound("%s with id %d not found in trash", entityType, id)
+		}
+		return state, apperrors.NotFound("%s with id %d not found", entityType, id)
+	}
+	if err != nil {
+		return state, wrapError(err, "failed to get "+entityType)
+	}
+
+	return state, nil
+}
+
+// purgeRow deletes a trashed row with its translations and revisions and
+// records the purge inside the caller's transaction
+func purgeRow(ctx context.Context, tx *database.Tx, tenantID int, entityType string, table trashTable, id int, before trashState) error {
+	for _, query := range []string{
+		`DELETE FROM translations WHERE tenant_id = ? AND entity_type = ? AND entity_id = ?`,
+		`DELETE FROM revisions WHERE tenant_id = ? AND entity_type = ? AND entity_id = ?`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, tenantID, entityType, id); err != nil {
+			return wrapError(err, "failed to purge "+entityType+" history")
+		}
+	}
+
+	query := `DELETE FROM ` + table.name + ` WHERE tenant_id = ? AND id = ?`
+	if _, err := tx.ExecContext(ctx, query, tenantID, id); err != nil {
+		return wrapError(err, "failed to purge "+entityType)
+	}
+
+	return recordAudit(ctx, tx, tenantID, entityType, id, models.AuditActionPurge, before, nil)
+}
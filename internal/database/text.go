@@ -0,0 +1,15 @@
+package database
+
+import "unicode/utf8"
+
+// TruncateUTF8 cuts s to at most n bytes without splitting a multi-byte
+// character, which strict utf8mb4 columns would reject
+func TruncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
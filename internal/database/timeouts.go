@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Timeouts bound each repository call by the kind of work it does, so a
+// stuck connection fails the call with apperrors.ErrUnavailable instead of
+// holding the request until the server's WriteTimeout. A zero duration
+// leaves the call bound by its parent context only.
+type Timeouts struct {
+	// Read covers single-row lookups and list queries
+	Read time.Duration
+	// Write covers inserts, updates and deletes and the transactions
+	// that run them
+	Write time.Duration
+	// Aggregate covers counts and other queries that scan many rows
+	Aggregate time.Duration
+}
+
+// Op is the operation class of a statement, selecting its timeout
+type Op int
+
+const (
+	Read Op = iota
+	Write
+	Aggregate
+)
+
+// Query runs a query bounded by the timeout of op. The deadline is
+// released when the rows are closed.
+func (db *DB) Query(ctx context.Context, op Op, query string, args ...interface{}) (*Rows, error) {
+	ctx, cancel := db.withTimeout(ctx, op)
+	rows, err := db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &Rows{Rows: rows, cancel: cancel}, nil
+}
+
+// QueryRow runs a single-row query bounded by the timeout of op. The
+// deadline is released by Scan.
+func (db *DB) QueryRow(ctx context.Context, op Op, query string, args ...interface{}) *Row {
+	ctx, cancel := db.withTimeout(ctx, op)
+	return &Row{row: db.DB.QueryRowContext(ctx, query, args...), cancel: cancel}
+}
+
+// Exec runs a statement bounded by the timeout of op
+func (db *DB) Exec(ctx context.Context, op Op, query string, args ...interface{}) (sql.Result, error) {
+	ctx, cancel := db.withTimeout(ctx, op)
+	defer cancel()
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+// Begin starts a transaction bounded by the timeout of op. The returned
+// context carries the deadline and must be used for the statements of the
+// transaction; the deadline is released by Commit or Rollback.
+func (db *DB) Begin(ctx context.Context, op Op) (context.Context, *Tx, error) {
+	ctx, cancel := db.withTimeout(ctx, op)
+	tx, err := db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		cancel()
+		return ctx, nil, err
+	}
+	return ctx, &Tx{Tx: tx, cancel: cancel}, nil
+}
+
+// Rows releases the deadline of its query when closed
+type Rows struct {
+	*sql.Rows
+	cancel context.CancelFunc
+}
+
+// Close closes the rows and releases the query deadline
+func (r *Rows) Close() error {
+	defer r.cancel()
+	return r.Rows.Close()
+}
+
+// Row releases the deadline of its query once scanned
+type Row struct {
+	row    *sql.Row
+	cancel context.CancelFunc
+}
+
+// Scan copies the row into dest and releases the query deadline
+func (r *Row) Scan(dest ...interface{}) error {
+	defer r.cancel()
+	return r.row.Scan(dest...)
+}
+
+// Tx releases the deadline of its transaction once it is committed or
+// rolled back
+type Tx struct {
+	*sql.Tx
+	cancel context.CancelFunc
+}
+
+// Commit commits the transaction and releases its deadline
+func (tx *Tx) Commit() error {
+	defer tx.cancel()
+	return tx.Tx.Commit()
+}
+
+// Rollback aborts the transaction and releases its deadline
+func (tx *Tx) Rollback() error {
+	defer tx.cancel()
+	return tx.Tx.Rollback()
+}
+
+func (db *DB) withTimeout(ctx context.Context, op Op) (context.Context, context.CancelFunc) {
+	var timeout time.Duration
+	switch op {
+	case Read:
+		timeout = db.timeouts.Read
+	case Write:
+		timeout = db.timeouts.Write
+	case Aggregate:
+		timeout = db.timeouts.Aggregate
+	}
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,243 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The driver wrapper below starts a span for every statement the
+// repositories run through QueryContext, QueryRowContext or ExecContext,
+// including those inside transactions. Spans carry the statement and the
+// number of rows returned or affected. Query spans end when the rows are
+// closed, so they cover reading the result. The same measurements are
+// added to the QueryStats of the connection pool.
+
+var tracer = otel.Tracer("portfolio-backend/internal/database")
+
+// tracedConnector wraps the MySQL connector so that every connection it
+// opens is traced
+type tracedConnector struct {
+	driver.Connector
+	stats *QueryStats
+}
+
+func (c *tracedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedConn{Conn: conn, stats: c.stats}, nil
+}
+
+// tracedConn forwards to the MySQL connection, which implements all the
+// optional interfaces used here
+type tracedConn struct {
+	driver.Conn
+	stats *QueryStats
+}
+
+var (
+	_ driver.ConnBeginTx        = (*tracedConn)(nil)
+	_ driver.ConnPrepareContext = (*tracedConn)(nil)
+	_ driver.QueryerContext     = (*tracedConn)(nil)
+	_ driver.ExecerContext      = (*tracedConn)(nil)
+	_ driver.Pinger             = (*tracedConn)(nil)
+	_ driver.SessionResetter    = (*tracedConn)(nil)
+	_ driver.Validator          = (*tracedConn)(nil)
+	_ driver.NamedValueChecker  = (*tracedConn)(nil)
+	_ driver.StmtQueryContext   = (*tracedStmt)(nil)
+	_ driver.StmtExecContext    = (*tracedStmt)(nil)
+	_ driver.NamedValueChecker  = (*tracedStmt)(nil)
+	_ driver.RowsNextResultSet  = (*tracedRows)(nil)
+)
+
+func (c *tracedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.Conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+func (c *tracedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.Conn.(driver.ConnPrepareContext).PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedStmt{Stmt: stmt, query: query, stats: c.stats}, nil
+}
+
+// QueryContext runs statements without arguments directly. The MySQL driver
+// returns driver.ErrSkip for the others, which database/sql then prepares;
+// no span is recorded for the skipped attempt.
+func (c *tracedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := c.Conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+	if errors.Is(err, driver.ErrSkip) {
+		return nil, err
+	}
+	return traceRows(ctx, c.stats, start, query, len(args), rows, err)
+}
+
+func (c *tracedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := c.Conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+	if errors.Is(err, driver.ErrSkip) {
+		return nil, err
+	}
+	return traceResult(ctx, c.stats, start, query, len(args), result, err)
+}
+
+func (c *tracedConn) Ping(ctx context.Context) error {
+	return c.Conn.(driver.Pinger).Ping(ctx)
+}
+
+func (c *tracedConn) ResetSession(ctx context.Context) error {
+	return c.Conn.(driver.SessionResetter).ResetSession(ctx)
+}
+
+func (c *tracedConn) IsValid() bool {
+	return c.Conn.(driver.Validator).IsValid()
+}
+
+func (c *tracedConn) CheckNamedValue(nv *driver.NamedValue) error {
+	return c.Conn.(driver.NamedValueChecker).CheckNamedValue(nv)
+}
+
+// tracedStmt traces the executions of a prepared statement
+type tracedStmt struct {
+	driver.Stmt
+	query string
+	stats *QueryStats
+}
+
+func (s *tracedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := s.Stmt.(driver.StmtQueryContext).QueryContext(ctx, args)
+	return traceRows(ctx, s.stats, start, s.query, len(args), rows, err)
+}
+
+func (s *tracedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := s.Stmt.(driver.StmtExecContext).ExecContext(ctx, args)
+	return traceResult(ctx, s.stats, start, s.query, len(args), result, err)
+}
+
+func (s *tracedStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// tracedRows counts the rows read and ends the query span on Close
+type tracedRows struct {
+	driver.Rows
+	ctx   context.Context
+	stats *QueryStats
+	start time.Time
+	query string
+	op    string
+	args  int
+	span  trace.Span
+	count int
+	err   error
+}
+
+func (r *tracedRows) Next(dest []driver.Value) error {
+	err := r.Rows.Next(dest)
+	if err == nil {
+		r.count++
+	} else if !errors.Is(err, io.EOF) {
+		recordError(r.span, err)
+		r.err = err
+	}
+	return err
+}
+
+func (r *tracedRows) Close() error {
+	err := r.Rows.Close()
+	r.span.SetAttributes(attribute.Int("db.rows_returned", r.count))
+	r.span.End()
+	r.stats.observe(r.ctx, r.query, r.op, time.Since(r.start), int64(r.count), r.args, r.err)
+	return err
+}
+
+func (r *tracedRows) HasNextResultSet() bool {
+	if next, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return next.HasNextResultSet()
+	}
+	return false
+}
+
+func (r *tracedRows) NextResultSet() error {
+	if next, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return next.NextResultSet()
+	}
+	return io.EOF
+}
+
+// startSpan starts a span backdated to start for a normalized statement
+func startSpan(ctx context.Context, start time.Time, statement, operation string) trace.Span {
+	_, span := tracer.Start(ctx, operation,
+		trace.WithTimestamp(start),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "mysql"),
+			attribute.String("db.operation", operation),
+			attribute.String("db.statement", statement),
+		),
+	)
+	return span
+}
+
+func traceRows(ctx context.Context, stats *QueryStats, start time.Time, query string, args int, rows driver.Rows, err error) (driver.Rows, error) {
+	statement, operation := normalizeStatement(query)
+	span := startSpan(ctx, start, statement, operation)
+	if err != nil {
+		recordError(span, err)
+		span.End()
+		stats.observe(ctx, statement, operation, time.Since(start), 0, args, err)
+		return nil, err
+	}
+	return &tracedRows{
+		Rows:  rows,
+		ctx:   ctx,
+		stats: stats,
+		start: start,
+		query: statement,
+		op:    operation,
+		args:  args,
+		span:  span,
+	}, nil
+}
+
+func traceResult(ctx context.Context, stats *QueryStats, start time.Time, query string, args int, result driver.Result, err error) (driver.Result, error) {
+	duration := time.Since(start)
+	statement, operation := normalizeStatement(query)
+	span := startSpan(ctx, start, statement, operation)
+	defer span.End()
+
+	if err != nil {
+		recordError(span, err)
+		stats.observe(ctx, statement, operation, duration, 0, args, err)
+		return nil, err
+	}
+
+	var affected int64
+	if n, err := result.RowsAffected(); err == nil {
+		affected = n
+		span.SetAttributes(attribute.Int64("db.rows_affected", affected))
+	}
+	stats.observe(ctx, statement, operation, duration, affected, args, nil)
+	return result, nil
+}
+
+func recordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,286 @@
+// Package diagnostics serves profiling and runtime state on the admin
+// listener: net/http/pprof, runtime and GC statistics, the effective
+// configuration with secrets masked, the rate limiter tables and the
+// slowest SQL statements. Every endpoint requires the ADMIN_SERVER_TOKEN
+// credential, which is separate from the API's admin tokens.
+package diagnostics
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/metrics"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"portfolio-backend/internal/buildinfo"
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/middleware"
+	"portfolio-backend/internal/redact"
+)
+
+// Diagnostics holds the state reported by the /debug endpoints
+type Diagnostics struct {
+	cfg     *config.Config
+	build   buildinfo.Info
+	started time.Time
+	token   [sha256.Size]byte
+
+	mu       sync.RWMutex
+	limiters map[string]*middleware.RateLimiter
+	queries  *database.QueryStats
+}
+
+// New creates the diagnostics for cfg. It returns nil when no
+// ADMIN_SERVER_TOKEN is configured, which disables the endpoints.
+func New(cfg *config.Config, build buildinfo.Info) *Diagnostics {
+	if cfg.AdminServer.Token == "" {
+		return nil
+	}
+
+	return &Diagnostics{
+		cfg:      cfg,
+		build:    build,
+		started:  time.Now(),
+		token:    sha256.Sum256([]byte(cfg.AdminServer.Token)),
+		limiters: make(map[string]*middleware.RateLimiter),
+	}
+}
+
+// RegisterRateLimiter adds a rate limiter to /debug/ratelimits
+func (d *Diagnostics) RegisterRateLimiter(name string, rl *middleware.RateLimiter) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.limiters[name] = rl
+}
+
+// RegisterQueryStats adds the statement statistics to /debug/queries
+func (d *Diagnostics) RegisterQueryStats(queries *database.QueryStats) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.queries = queries
+}
+
+// Register mounts the /debug endpoints on mux
+func (d *Diagnostics) Register(mux *http.ServeMux) {
+	mux.Handle("GET /debug/pprof/", d.authorize(http.HandlerFunc(pprof.Index)))
+	mux.Handle("GET /debug/pprof/cmdline", d.authorize(http.HandlerFunc(pprof.Cmdline)))
+	mux.Handle("GET /debug/pprof/profile", d.authorize(http.HandlerFunc(pprof.Profile)))
+	mux.Handle("GET /debug/pprof/symbol", d.authorize(http.HandlerFunc(pprof.Symbol)))
+	mux.Handle("POST /debug/pprof/symbol", d.authorize(http.HandlerFunc(pprof.Symbol)))
+	mux.Handle("GET /debug/pprof/trace", d.authorize(http.HandlerFunc(pprof.Trace)))
+	mux.Handle("GET /debug/runtime", d.authorize(http.HandlerFunc(d.runtimeStats)))
+	mux.Handle("GET /debug/config", d.authorize(http.HandlerFunc(d.config)))
+	mux.Handle("GET /debug/ratelimits", d.authorize(http.HandlerFunc(d.rateLimits)))
+	mux.Handle("GET /debug/queries", d.authorize(http.HandlerFunc(d.topQueries)))
+	mux.Handle("DELETE /debug/queries", d.authorize(http.HandlerFunc(d.resetQueries)))
+}
+
+// authorize accepts the token as a bearer token or as the password of
+// HTTP basic auth, which lets `go tool pprof` pass it in the URL
+func (d *Diagnostics) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			_, token, _ = r.BasicAuth()
+		}
+
+		// Compare digests so the comparison time does not leak the length
+		digest := sha256.Sum256([]byte(token))
+		if token == "" || subtle.ConstantTimeCompare(digest[:], d.token[:]) != 1 {
+			host := r.RemoteAddr
+			if h, _, err := net.SplitHostPort(host); err == nil {
+				host = h
+			}
+			log.Warn().
+				Str("path", r.URL.Path).
+				Str("remote_addr", redact.IP(host)).
+				Msg("Rejected diagnostics request")
+			w.Header().Set("WWW-Authenticate", `Basic realm="diagnostics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RuntimeStats is the payload of /debug/runtime
+type RuntimeStats struct {
+	Build      buildinfo.Info `json:"build"`
+	Uptime     string         `json:"uptime"`
+	Goroutines int            `json:"goroutines"`
+	NumCPU     int            `json:"num_cpu"`
+	GOMAXPROCS int            `json:"gomaxprocs"`
+	Memory     MemoryStats    `json:"memory"`
+	GC         GCStats        `json:"gc"`
+}
+
+// MemoryStats summarizes runtime.MemStats, in bytes
+type MemoryStats struct {
+	Sys         uint64 `json:"sys"`
+	HeapAlloc   uint64 `json:"heap_alloc"`
+	HeapInuse   uint64 `json:"heap_inuse"`
+	HeapIdle    uint64 `json:"heap_idle"`
+	HeapObjects uint64 `json:"heap_objects"`
+	StackInuse  uint64 `json:"stack_inuse"`
+	TotalAlloc  uint64 `json:"total_alloc"`
+}
+
+// GCStats summarizes the garbage collector's activity
+type GCStats struct {
+	NumGC       uint32    `json:"num_gc"`
+	LastGC      time.Time `json:"last_gc"`
+	LastPause   string    `json:"last_pause"`
+	PauseTotal  string    `json:"pause_total"`
+	NextGC      uint64    `json:"next_gc"`
+	CPUFraction float64   `json:"cpu_fraction"`
+	GOGC        int       `json:"gogc"`
+	MemoryLimit int64     `json:"memory_limit"`
+}
+
+func (d *Diagnostics) runtimeStats(w http.ResponseWriter, r *http.Request) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	var lastPause time.Duration
+	if mem.NumGC > 0 {
+		lastPause = time.Duration(mem.PauseNs[(mem.NumGC+255)%256])
+	}
+
+	gogc, memoryLimit := gcSettings()
+
+	writeJSON(w, RuntimeStats{
+		Build:      d.build,
+		Uptime:     time.Since(d.started).Round(time.Second).String(),
+		Goroutines: runtime.NumGoroutine(),
+		NumCPU:     runtime.NumCPU(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		Memory: MemoryStats{
+			Sys:         mem.Sys,
+			HeapAlloc:   mem.HeapAlloc,
+			HeapInuse:   mem.HeapInuse,
+			HeapIdle:    mem.HeapIdle,
+			HeapObjects: mem.HeapObjects,
+			StackInuse:  mem.StackInuse,
+			TotalAlloc:  mem.TotalAlloc,
+		},
+		GC: GCStats{
+			NumGC:       mem.NumGC,
+			LastGC:      time.Unix(0, int64(mem.LastGC)),
+			LastPause:   lastPause.String(),
+			PauseTotal:  time.Duration(mem.PauseTotalNs).String(),
+			NextGC:      mem.NextGC,
+			CPUFraction: mem.GCCPUFraction,
+			GOGC:        gogc,
+			MemoryLimit: memoryLimit,
+		},
+	})
+}
+
+// gcSettings reads GOGC and the memory limit from runtime/metrics, which
+// never changes them, unlike a SetGCPercent round trip racing with other
+// callers. GOGC=off is reported as -1.
+func gcSettings() (gogc int, memoryLimit int64) {
+	samples := []metrics.Sample{
+		{Name: "/gc/gogc:percent"},
+		{Name: "/gc/gomemlimit:bytes"},
+	}
+	metrics.Read(samples)
+
+	gogc = -1
+	if samples[0].Value.Kind() == metrics.KindUint64 {
+		if percent := samples[0].Value.Uint64(); percent <= math.MaxInt32 {
+			gogc = int(percent)
+		}
+	}
+	if samples[1].Value.Kind() == metrics.KindUint64 {
+		memoryLimit = int64(min(samples[1].Value.Uint64(), math.MaxInt64))
+	}
+	return gogc, memoryLimit
+}
+
+func (d *Diagnostics) config(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, d.cfg.Redacted())
+}
+
+func (d *Diagnostics) rateLimits(w http.ResponseWriter, r *http.Request) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	tables := make(map[string][]middleware.ClientStats, len(d.limiters))
+	for name, rl := range d.limiters {
+		tables[name] = rl.Clients()
+	}
+	writeJSON(w, tables)
+}
+
+// topQueries reports the ?limit= (default 20) statements ranked by ?sort=
+// total, mean, max, calls or errors
+func (d *Diagnostics) topQueries(w http.ResponseWriter, r *http.Request) {
+	queries := d.queryStats()
+	if queries == nil {
+		http.Error(w, "query statistics are not available", http.StatusNotFound)
+		return
+	}
+
+	limit := 20
+	if value := r.URL.Query().Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			http.Error(w, "limit must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	sortBy := database.QuerySort(r.URL.Query().Get("sort"))
+	switch sortBy {
+	case "":
+		sortBy = database.SortByTotal
+	case database.SortByTotal, database.SortByMean, database.SortByMax, database.SortByCalls, database.SortByErrors:
+	default:
+		http.Error(w, "sort must be total, mean, max, calls or errors", http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(w, queries.Top(limit, sortBy))
+}
+
+func (d *Diagnostics) resetQueries(w http.ResponseWriter, r *http.Request) {
+	queries := d.queryStats()
+	if queries == nil {
+		http.Error(w, "query statistics are not available", http.StatusNotFound)
+		return
+	}
+
+	queries.Reset()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (d *Diagnostics) queryStats() *database.QueryStats {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.queries
+}
+
+func writeJSON(w http.ResponseWriter, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(payload); err != nil {
+		log.Error().Err(err).Msg("Failed to encode diagnostics response")
+	}
+}
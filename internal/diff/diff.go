@@ -0,0 +1,79 @@
+package diff
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"portfolio-backend/internal/models"
+)
+
+// ignoredFields are bookkeeping columns left out of diffs
+var ignoredFields = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+}
+
+// Fields returns the fields whose JSON representation differs between before
+// and after, sorted by field name. A nil before or after has no fields, so
+// creations and deletions list every field.
+func Fields(before, after interface{}) ([]models.FieldChange, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool)
+	for name := range beforeFields {
+		names[name] = true
+	}
+	for name := range afterFields {
+		names[name] = true
+	}
+
+	changes := []models.FieldChange{}
+	for name := range names {
+		if ignoredFields[name] {
+			continue
+		}
+		oldValue, newValue := beforeFields[name], afterFields[name]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, models.FieldChange{
+			Field:  name,
+			Before: oldValue,
+			After:  newValue,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes, nil
+}
+
+// jsonFields flattens an entity into its JSON fields
+func jsonFields(entity interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if entity == nil {
+		return fields, nil
+	}
+	if value := reflect.ValueOf(entity); value.Kind() == reflect.Ptr && value.IsNil() {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+)
+
+type AuditHandler struct {
+	auditService services.AuditService
+}
+
+func NewAuditHandler(auditService services.AuditService) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+	}
+}
+
+// ListAuditEvents handles GET /v1/admin/audit-events
+func (h *AuditHandler) ListAuditEvents(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	filter, validationErrors := parseAuditFilter(c)
+	if validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	opts, validationErrors := parseListOptions(c)
+	if validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+	filter.ListOptions = opts
+
+	events, pagination, err := h.auditService.ListAuditEvents(ctx, tenantID, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to list audit events")
+		response.HandleError(c, err, "Failed to list audit events")
+		return
+	}
+
+	response.Paginated(c, events, pagination)
+}
+
+// parseAuditFilter reads the ?entity_type=, ?entity_id=, ?actor=, ?from= and
+// ?to= query parameters; times are RFC 3339
+func parseAuditFilter(c *gin.Context) (models.AuditFilter, map[string]interface{}) {
+	filter := models.AuditFilter{
+		EntityType: c.Query("entity_type"),
+		Actor:      c.Query("actor"),
+	}
+	errors := make(map[string]interface{})
+
+	if value := c.Query("entity_id"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			errors["entity_id"] = "entity_id must be an integer"
+		} else {
+			filter.EntityID = &id
+		}
+	}
+
+	for _, param := range []struct {
+		name string
+		dest **time.Time
+	}{
+		{"from", &filter.From},
+		{"to", &filter.To},
+	} {
+		value := c.Query(param.name)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			errors[param.name] = param.name + " must be an RFC 3339 timestamp"
+			continue
+		}
+		*param.dest = &t
+	}
+
+	if len(errors) > 0 {
+		return filter, errors
+	}
+	return filter, nil
+}
@@ -2,30 +2,31 @@ package handlers
 
 import (
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
-	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/services"
 	"portfolio-backend/pkg/response"
 )
 
 type CertificationsHandler struct {
-	certificationRepo repositories.CertificationRepository
+	certificationService services.CertificationService
 }
 
-func NewCertificationsHandler(certificationRepo repositories.CertificationRepository) *CertificationsHandler {
+func NewCertificationsHandler(certificationService services.CertificationService) *CertificationsHandler {
 	return &CertificationsHandler{
-		certificationRepo: certificationRepo,
+		certificationService: certificationService,
 	}
 }
 
 // GetCertifications handles GET /v1/certifications
 func (h *CertificationsHandler) GetCertifications(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
-	certifications, err := h.certificationRepo.GetAllCertifications(ctx)
+	certifications, err := h.certificationService.GetAllCertifications(ctx, tenantID, contentScope(c))
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get certifications")
-		response.InternalServerError(c, err, "Failed to get certifications")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get certifications")
+		response.HandleError(c, err, "Failed to get certifications")
 		return
 	}
 
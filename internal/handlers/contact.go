@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+	"portfolio-backend/pkg/validator"
+)
+
+type ContactHandler struct {
+	contactService services.ContactService
+}
+
+func NewContactHandler(contactService services.ContactService) *ContactHandler {
+	return &ContactHandler{
+		contactService: contactService,
+	}
+}
+
+// GetFormToken handles GET /v1/contact/token
+func (h *ContactHandler) GetFormToken(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	token, err := h.contactService.IssueFormToken(ctx, tenantID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to issue contact form token")
+		response.HandleError(c, err, "Failed to issue contact form token")
+		return
+	}
+
+	response.Success(c, token)
+}
+
+// SubmitContact handles POST /v1/contact
+func (h *ContactHandler) SubmitContact(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	var req models.ContactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
+		response.BadRequest(c, err, "Invalid request body")
+		return
+	}
+
+	// Validate the request
+	if validationErrors := validator.ValidateStruct(req); validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	if err := h.contactService.SubmitMessage(ctx, tenantID, req, c.ClientIP(), c.Request.UserAgent()); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to submit contact message")
+		response.HandleError(c, err, "Failed to submit message")
+		return
+	}
+
+	response.Created(c, nil, "Message received")
+}
@@ -2,30 +2,31 @@ package handlers
 
 import (
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
-	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/services"
 	"portfolio-backend/pkg/response"
 )
 
 type EducationHandler struct {
-	educationRepo repositories.EducationRepository
+	educationService services.EducationService
 }
 
-func NewEducationHandler(educationRepo repositories.EducationRepository) *EducationHandler {
+func NewEducationHandler(educationService services.EducationService) *EducationHandler {
 	return &EducationHandler{
-		educationRepo: educationRepo,
+		educationService: educationService,
 	}
 }
 
 // GetEducation handles GET /v1/education
 func (h *EducationHandler) GetEducation(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
-	education, err := h.educationRepo.GetAllEducation(ctx)
+	education, err := h.educationService.GetAllEducation(ctx, tenantID, contentScope(c))
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get education")
-		response.InternalServerError(c, err, "Failed to get education")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get education")
+		response.HandleError(c, err, "Failed to get education")
 		return
 	}
 
@@ -4,7 +4,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"portfolio-backend/internal/services"
 	"portfolio-backend/pkg/response"
@@ -23,11 +23,12 @@ func NewExperienceHandler(experienceService services.ExperienceService) *Experie
 // GetAllExperiences handles GET /v1/experience
 func (h *ExperienceHandler) GetAllExperiences(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
-	experiences, err := h.experienceService.GetAllExperiences(ctx)
+	experiences, err := h.experienceService.GetAllExperiences(ctx, tenantID, contentScope(c))
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get experiences")
-		response.InternalServerError(c, err, "Failed to get experiences")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get experiences")
+		response.HandleError(c, err, "Failed to get experiences")
 		return
 	}
 
@@ -37,26 +38,20 @@ func (h *ExperienceHandler) GetAllExperiences(c *gin.Context) {
 // GetExperienceByID handles GET /v1/experience/{id}
 func (h *ExperienceHandler) GetExperienceByID(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
 	idParam := c.Param("id")
 	id, err := strconv.Atoi(idParam)
 	if err != nil {
-		log.Warn().Str("id", idParam).Msg("Invalid experience ID")
+		zerolog.Ctx(ctx).Warn().Str("id", idParam).Msg("Invalid experience ID")
 		response.BadRequest(c, err, "Invalid experience ID")
 		return
 	}
 
-	experience, err := h.experienceService.GetExperienceByID(ctx, id)
+	experience, err := h.experienceService.GetExperienceByID(ctx, tenantID, id, contentScope(c))
 	if err != nil {
-		log.Error().Err(err).Int("id", id).Msg("Failed to get experience")
-		
-		// Check if it's a not found error
-		if err.Error() == "experience with id "+idParam+" not found" {
-			response.NotFound(c, err, "Experience not found")
-			return
-		}
-		
-		response.InternalServerError(c, err, "Failed to get experience")
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to get experience")
+		response.HandleError(c, err, "Failed to get experience")
 		return
 	}
 
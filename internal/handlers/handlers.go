@@ -1,26 +1,42 @@
 package handlers
 
 import (
-	"database/sql"
+	"github.com/gin-gonic/gin"
 
+	"portfolio-backend/internal/config"
 	"portfolio-backend/internal/database"
 	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/health"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/ogimage"
 	"portfolio-backend/internal/services"
+	"portfolio-backend/internal/storage"
+	"portfolio-backend/internal/tenant"
 )
 
 // Handlers holds all the HTTP handlers and their dependencies
 type Handlers struct {
-	Profile       *ProfileHandler
-	Experience    *ExperienceHandler
-	Skills        *SkillsHandler
-	Education     *EducationHandler
+	Profile        *ProfileHandler
+	Experience     *ExperienceHandler
+	Skills         *SkillsHandler
+	Education      *EducationHandler
 	Certifications *CertificationsHandler
-	Projects      *ProjectHandler
-	Health        *HealthHandler
+	Projects       *ProjectHandler
+	ProjectMedia   *ProjectMediaHandler
+	Contact        *ContactHandler
+	Messages       *MessageHandler
+	Audit          *AuditHandler
+	Revisions      *RevisionHandler
+	Publication    *PublicationHandler
+	Trash          *TrashHandler
+	Images         *ImageHandler
+	OGImages       *OGImageHandler
+	Health         *HealthHandler
+	Version        *VersionHandler
 }
 
 // NewHandlers creates and initializes all handlers
-func NewHandlers(db *sql.DB) *Handlers {
+func NewHandlers(db *database.DB, cfg *config.Config, blobStore storage.BlobStore) *Handlers {
 	// Initialize repositories
 	profileRepo := repositories.NewProfileRepository(db)
 	experienceRepo := repositories.NewExperienceRepository(db)
@@ -28,23 +44,96 @@ func NewHandlers(db *sql.DB) *Handlers {
 	educationRepo := repositories.NewEducationRepository(db)
 	certificationRepo := repositories.NewCertificationRepository(db)
 	projectRepo := repositories.NewProjectRepository(db)
+	projectMediaRepo := repositories.NewProjectMediaRepository(db)
+	translationRepo := repositories.NewTranslationRepository(db)
+	messageRepo := repositories.NewMessageRepository(db)
+	auditRepo := repositories.NewAuditRepository(db)
+	revisionRepo := repositories.NewRevisionRepository(db)
+	publicationRepo := repositories.NewPublicationRepository(db)
+	trashRepo := repositories.NewTrashRepository(db)
 
 	// Initialize services
-	profileService := services.NewProfileService(profileRepo)
-	experienceService := services.NewExperienceService(experienceRepo)
-	projectService := services.NewProjectService(projectRepo)
-	
-	// Create a DB wrapper for health service
-	dbWrapper := &database.DB{DB: db}
-	healthService := services.NewHealthService(dbWrapper)
+	localizer := services.NewLocalizer(translationRepo, cfg.I18n.DefaultLocale)
+	privacyFilter := services.NewPrivacyFilter(cfg.Privacy)
+	revisionService := services.NewRevisionService(revisionRepo, profileRepo, projectRepo, cfg.Revisions)
+	profileService := services.NewProfileService(profileRepo, localizer, privacyFilter, revisionService)
+	experienceService := services.NewExperienceService(experienceRepo, localizer)
+	skillService := services.NewSkillService(skillRepo, localizer)
+	educationService := services.NewEducationService(educationRepo, localizer)
+	certificationService := services.NewCertificationService(certificationRepo, localizer)
+	projectService := services.NewProjectService(projectRepo, projectMediaRepo, localizer, revisionService)
+	projectMediaService := services.NewProjectMediaService(projectMediaRepo)
+	contactService := services.NewContactService(messageRepo, profileRepo, cfg.Contact)
+	inboxService := services.NewInboxService(messageRepo)
+	auditService := services.NewAuditService(auditRepo)
+	publicationService := services.NewPublicationService(publicationRepo)
+	trashService := services.NewTrashService(trashRepo)
+	imageService := services.NewImageService(projectRepo, blobStore, cfg.Uploads, revisionService)
+	ogImageService := services.NewOGImageService(projectService, profileService, ogimage.NewRenderer(), ogimage.NewCache(cfg.OGImage.CacheDir))
+
+	// Component checks behind /v1/health and the probes
+	healthRegistry := health.NewRegistry(cfg.Health.CheckTimeout, cfg.Health.CacheTTL)
+	healthRegistry.Register(health.Check{
+		Name:     "database",
+		Probes:   []health.Probe{health.Readiness, health.Startup},
+		Critical: true,
+		Run:      health.Database(db),
+	})
+	healthRegistry.Register(health.Check{
+		Name:     "migrations",
+		Probes:   []health.Probe{health.Readiness, health.Startup},
+		Critical: true,
+		Run:      health.Migrations(db, database.SchemaVersion),
+	})
+	healthRegistry.Register(health.Check{
+		Name:   "og_cache",
+		Probes: []health.Probe{health.Readiness},
+		Run:    health.WritableDir(cfg.OGImage.CacheDir),
+	})
+	healthRegistry.Register(health.Check{
+		Name:   "mail_outbox",
+		Probes: []health.Probe{health.Readiness},
+		Run:    health.OutboxLag(repositories.NewOutboxRepository(db), cfg.Health.MaxOutboxLag),
+	})
+	healthService := services.NewHealthService(healthRegistry)
 
 	return &Handlers{
-		Profile:       NewProfileHandler(profileService),
-		Experience:    NewExperienceHandler(experienceService),
-		Skills:        NewSkillsHandler(skillRepo),
-		Education:     NewEducationHandler(educationRepo),
-		Certifications: NewCertificationsHandler(certificationRepo),
-		Projects:      NewProjectHandler(projectService),
-		Health:        NewHealthHandler(healthService),
+		Profile:        NewProfileHandler(profileService),
+		Experience:     NewExperienceHandler(experienceService),
+		Skills:         NewSkillsHandler(skillService),
+		Education:      NewEducationHandler(educationService),
+		Certifications: NewCertificationsHandler(certificationService),
+		Projects:       NewProjectHandler(projectService),
+		ProjectMedia:   NewProjectMediaHandler(projectMediaService),
+		Contact:        NewContactHandler(contactService),
+		Messages:       NewMessageHandler(inboxService),
+		Audit:          NewAuditHandler(auditService),
+		Revisions:      NewRevisionHandler(revisionService),
+		Publication:    NewPublicationHandler(publicationService),
+		Trash:          NewTrashHandler(trashService),
+		Images:         NewImageHandler(imageService, cfg.Uploads.MaxBytes),
+		OGImages:       NewOGImageHandler(ogImageService),
+		Health:         NewHealthHandler(healthService),
+		Version:        NewVersionHandler(),
+	}
+}
+
+// currentTenantID returns the ID of the tenant resolved by middleware.Tenant.
+// It returns 0, which matches no rows, when no tenant was resolved.
+func currentTenantID(c *gin.Context) int {
+	if t, ok := tenant.FromContext(c.Request.Context()); ok {
+		return t.ID
 	}
-}
\ No newline at end of file
+	return 0
+}
+
+// contentScope returns the content scope set by middleware.IncludeUnpublished.
+// Requests without it only see currently published entries.
+func contentScope(c *gin.Context) models.Scope {
+	if scope, ok := c.Get("scope"); ok {
+		if s, ok := scope.(models.Scope); ok {
+			return s
+		}
+	}
+	return models.ScopePublished
+}
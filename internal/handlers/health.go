@@ -1,9 +1,14 @@
 package handlers
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
+	"portfolio-backend/internal/auth"
+	"portfolio-backend/internal/health"
 	"portfolio-backend/internal/services"
 	"portfolio-backend/pkg/response"
 )
@@ -23,17 +28,72 @@ func (h *HealthHandler) GetHealth(c *gin.Context) {
 	ctx := c.Request.Context()
 
 	health, err := h.healthService.CheckHealth(ctx)
-	if err != nil {
-		log.Error().Err(err).Msg("Health check failed")
-		response.InternalServerError(c, err, "Health check failed")
-		return
-	}
 
 	// If health check indicates unhealthy status, return appropriate status code
-	if health.Status != "healthy" {
-		c.JSON(503, health) // Service Unavailable
+	if health.Status == "unhealthy" {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Service is unhealthy")
+		response.ServiceUnavailable(c, err, "Service is unhealthy", map[string]interface{}{
+			"status":     health.Status,
+			"timestamp":  health.Timestamp,
+			"version":    health.Version,
+			"commit":     health.Commit,
+			"components": health.Components,
+		})
 		return
 	}
 
 	response.Success(c, health)
-}
\ No newline at end of file
+}
+
+// Livez handles GET /livez
+func (h *HealthHandler) Livez(c *gin.Context) {
+	h.probe(c, health.Liveness)
+}
+
+// Readyz handles GET /readyz
+func (h *HealthHandler) Readyz(c *gin.Context) {
+	h.probe(c, health.Readiness)
+}
+
+// Startupz handles GET /startupz
+func (h *HealthHandler) Startupz(c *gin.Context) {
+	h.probe(c, health.Startup)
+}
+
+// probe answers 200 or 503 with the probe status. With ?verbose,
+// super-admins also get every check's result; the errors can reveal
+// infrastructure shared by all tenants, so other callers only see the status.
+func (h *HealthHandler) probe(c *gin.Context, probe health.Probe) {
+	ctx := c.Request.Context()
+
+	_, verbose := c.GetQuery("verbose")
+	if verbose {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			c.Header("WWW-Authenticate", `Bearer realm="admin"`)
+			response.Unauthorized(c, errors.New("missing admin token"), "A valid admin token is required for verbose probes")
+			return
+		}
+		if !principal.SuperAdmin() {
+			response.Forbidden(c, errors.New("verbose probe requested by a tenant admin"), "A super-admin token is required for verbose probes")
+			return
+		}
+	}
+
+	report := h.healthService.Probe(ctx, probe)
+
+	statusCode := http.StatusOK
+	if !report.OK() {
+		statusCode = http.StatusServiceUnavailable
+		zerolog.Ctx(ctx).Warn().
+			Str("probe", string(probe)).
+			Interface("checks", report.Checks).
+			Msg("Probe failing")
+	}
+
+	if verbose {
+		c.JSON(statusCode, report)
+		return
+	}
+	c.JSON(statusCode, gin.H{"status": report.Status})
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+)
+
+// multipartOverhead leaves room for multipart boundaries and headers on top of
+// the maximum file size
+const multipartOverhead = 64 << 10
+
+// allowedImageTypes are the sniffed content types accepted for uploads
+var allowedImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// ImageHandler handles project image uploads and serves stored images
+type ImageHandler struct {
+	imageService services.ImageService
+	maxBytes     int64
+}
+
+func NewImageHandler(imageService services.ImageService, maxBytes int64) *ImageHandler {
+	return &ImageHandler{
+		imageService: imageService,
+		maxBytes:     maxBytes,
+	}
+}
+
+// UploadProjectImage handles POST /v1/admin/projects/{id}/image
+func (h *ImageHandler) UploadProjectImage(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	id, ok := parseEntryID(c, models.EntityProject)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxBytes+multipartOverhead)
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.tooLarge(c, err)
+			return
+		}
+		response.ValidationError(c, map[string]interface{}{
+			"image": "is required as a multipart file",
+		})
+		return
+	}
+	if fileHeader.Size > h.maxBytes {
+		h.tooLarge(c, nil)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to open uploaded image")
+		response.InternalServerError(c, err, "Failed to read uploaded image")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, h.maxBytes+1))
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to read uploaded image")
+		response.InternalServerError(c, err, "Failed to read uploaded image")
+		return
+	}
+	if int64(len(data)) > h.maxBytes {
+		h.tooLarge(c, nil)
+		return
+	}
+
+	// Trust the file contents, not the client-supplied content type
+	if contentType := http.DetectContentType(data); !allowedImageTypes[contentType] {
+		response.UnsupportedMediaType(c, nil, "Unsupported image type", map[string]interface{}{
+			"image":        "must be a JPEG, PNG, GIF or WebP image",
+			"content_type": contentType,
+		})
+		return
+	}
+
+	image, err := h.imageService.UploadProjectImage(ctx, tenantID, id, data)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to upload project image")
+		response.HandleError(c, err, "Failed to upload project image")
+		return
+	}
+
+	response.Success(c, image, "Project image uploaded successfully")
+}
+
+// ServeImage handles GET /media/{key}. Keys never change content, so
+// responses may be cached forever.
+func (h *ImageHandler) ServeImage(c *gin.Context) {
+	ctx := c.Request.Context()
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	body, info, err := h.imageService.OpenImage(ctx, key)
+	if err != nil {
+		response.HandleError(c, err, "Failed to get image")
+		return
+	}
+	defer body.Close()
+
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.Header("Last-Modified", info.ModTime.UTC().Format(http.TimeFormat))
+	c.DataFromReader(http.StatusOK, info.Size, info.ContentType, body, nil)
+}
+
+// tooLarge responds with 413 for uploads above the size limit
+func (h *ImageHandler) tooLarge(c *gin.Context, err error) {
+	response.RequestEntityTooLarge(c, err, "Image too large", map[string]interface{}{
+		"image": fmt.Sprintf("must be at most %d bytes", h.maxBytes),
+	})
+}
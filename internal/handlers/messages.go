@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+	"portfolio-backend/pkg/validator"
+)
+
+type MessageHandler struct {
+	inboxService services.InboxService
+}
+
+func NewMessageHandler(inboxService services.InboxService) *MessageHandler {
+	return &MessageHandler{
+		inboxService: inboxService,
+	}
+}
+
+// ListMessages handles GET /v1/admin/messages
+func (h *MessageHandler) ListMessages(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	filter, validationErrors := parseMessageFilter(c)
+	if validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	opts, validationErrors := parseListOptions(c)
+	if validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+	filter.ListOptions = opts
+
+	messages, pagination, err := h.inboxService.ListMessages(ctx, tenantID, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to list messages")
+		response.HandleError(c, err, "Failed to list messages")
+		return
+	}
+
+	response.Paginated(c, messages, pagination)
+}
+
+// GetMessage handles GET /v1/admin/messages/{id}
+func (h *MessageHandler) GetMessage(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	id, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	msg, err := h.inboxService.GetMessage(ctx, tenantID, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to get message")
+		response.HandleError(c, err, "Failed to get message")
+		return
+	}
+
+	response.Success(c, msg)
+}
+
+// UpdateMessage handles PATCH /v1/admin/messages/{id}
+func (h *MessageHandler) UpdateMessage(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	id, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
+		response.BadRequest(c, err, "Invalid request body")
+		return
+	}
+
+	// Validate the request
+	if validationErrors := validator.ValidateStruct(req); validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	msg, err := h.inboxService.UpdateMessage(ctx, tenantID, id, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to update message")
+		response.HandleError(c, err, "Failed to update message")
+		return
+	}
+
+	response.Success(c, msg, "Message updated successfully")
+}
+
+// UpdateMessages handles POST /v1/admin/messages/mark
+func (h *MessageHandler) UpdateMessages(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	var req models.BulkUpdateMessagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
+		response.BadRequest(c, err, "Invalid request body")
+		return
+	}
+
+	// Validate the request
+	if validationErrors := validator.ValidateStruct(req); validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	result, err := h.inboxService.UpdateMessages(ctx, tenantID, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to update messages")
+		response.HandleError(c, err, "Failed to update messages")
+		return
+	}
+
+	response.Success(c, result, "Messages updated successfully")
+}
+
+// DeleteMessage handles DELETE /v1/admin/messages/{id}
+func (h *MessageHandler) DeleteMessage(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	id, ok := parseMessageID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.inboxService.DeleteMessage(ctx, tenantID, id); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to delete message")
+		response.HandleError(c, err, "Failed to delete message")
+		return
+	}
+
+	response.Success(c, nil, "Message deleted successfully")
+}
+
+// DeleteMessages handles POST /v1/admin/messages/delete
+func (h *MessageHandler) DeleteMessages(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	var req models.BulkDeleteMessagesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
+		response.BadRequest(c, err, "Invalid request body")
+		return
+	}
+
+	// Validate the request
+	if validationErrors := validator.ValidateStruct(req); validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	result, err := h.inboxService.DeleteMessages(ctx, tenantID, req.IDs)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to delete messages")
+		response.HandleError(c, err, "Failed to delete messages")
+		return
+	}
+
+	response.Success(c, result, "Messages deleted successfully")
+}
+
+// ExportMessages handles GET /v1/admin/messages/export?format=csv|mbox
+func (h *MessageHandler) ExportMessages(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	format := strings.ToLower(c.DefaultQuery("format", "csv"))
+	if format != "csv" && format != "mbox" {
+		response.ValidationError(c, map[string]interface{}{
+			"format": "format must be one of: csv mbox",
+		})
+		return
+	}
+
+	filter, validationErrors := parseMessageFilter(c)
+	if validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	messages, err := h.inboxService.ExportMessages(ctx, tenantID, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to export messages")
+		response.HandleError(c, err, "Failed to export messages")
+		return
+	}
+
+	filename := fmt.Sprintf("messages-%s.%s", time.Now().UTC().Format("20060102"), format)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	if format == "mbox" {
+		c.Data(http.StatusOK, "application/mbox", encodeMessagesMbox(messages))
+		return
+	}
+
+	body, err := encodeMessagesCSV(messages)
+	if err != nil {
+		response.InternalServerError(c, err, "Failed to export messages")
+		return
+	}
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", body)
+}
+
+// parseMessageFilter reads the ?status=, ?spam= and ?q= query parameters
+func parseMessageFilter(c *gin.Context) (models.MessageFilter, map[string]interface{}) {
+	filter := models.MessageFilter{
+		Status: c.Query("status"),
+		Search: strings.TrimSpace(c.Query("q")),
+	}
+	errors := make(map[string]interface{})
+
+	switch filter.Status {
+	case "", models.MessageStatusUnread, models.MessageStatusRead, models.MessageStatusArchived:
+	default:
+		errors["status"] = "status must be one of: unread read archived"
+	}
+
+	if value := c.Query("spam"); value != "" {
+		spam, err := strconv.ParseBool(value)
+		if err != nil {
+			errors["spam"] = "spam must be true or false"
+		} else {
+			filter.Spam = &spam
+		}
+	}
+
+	if len(errors) > 0 {
+		return filter, errors
+	}
+	return filter, nil
+}
+
+// parseMessageID reads the :id path parameter, writing a 400 response when invalid
+func parseMessageID(c *gin.Context) (int, bool) {
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		zerolog.Ctx(c.Request.Context()).Warn().Str("id", idParam).Msg("Invalid message ID")
+		response.BadRequest(c, err, "Invalid message ID")
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"mime"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"portfolio-backend/internal/models"
+)
+
+// encodeMessagesCSV renders messages as CSV with a header row
+func encodeMessagesCSV(messages []models.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{"id", "created_at", "status", "is_spam", "spam_reason", "name", "email", "subject", "body", "ip_address", "user_agent"}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, msg := range messages {
+		spamReason := ""
+		if msg.SpamReason != nil {
+			spamReason = *msg.SpamReason
+		}
+
+		record := []string{
+			strconv.Itoa(msg.ID),
+			msg.CreatedAt.UTC().Format(time.RFC3339),
+			msg.Status,
+			strconv.FormatBool(msg.IsSpam),
+			spamReason,
+			csvSafe(msg.Name),
+			csvSafe(msg.Email),
+			csvSafe(msg.Subject),
+			csvSafe(msg.Body),
+			msg.IPAddress,
+			csvSafe(msg.UserAgent),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// csvSafe prefixes values spreadsheets would evaluate as formulas, since
+// contact form input is untrusted
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// encodeMessagesMbox renders messages in mboxrd format so they can be opened
+// in a regular mail client
+func encodeMessagesMbox(messages []models.Message) []byte {
+	var buf bytes.Buffer
+
+	for _, msg := range messages {
+		from := (&mail.Address{Name: msg.Name, Address: msg.Email}).String()
+
+		fmt.Fprintf(&buf, "From %s %s\n", msg.Email, msg.CreatedAt.UTC().Format(time.ANSIC))
+		fmt.Fprintf(&buf, "From: %s\n", from)
+		fmt.Fprintf(&buf, "Date: %s\n", msg.CreatedAt.Format(time.RFC1123Z))
+		fmt.Fprintf(&buf, "Subject: %s\n", mime.QEncoding.Encode("utf-8", mboxHeader(msg.Subject)))
+		fmt.Fprintf(&buf, "Message-ID: <message-%d@portfolio>\n", msg.ID)
+		fmt.Fprintf(&buf, "X-Portfolio-Status: %s\n", msg.Status)
+		if msg.IsSpam {
+			buf.WriteString("X-Spam-Flag: YES\n")
+		}
+		buf.WriteString("MIME-Version: 1.0\n")
+		buf.WriteString("Content-Type: text/plain; charset=utf-8\n")
+		buf.WriteString("Content-Transfer-Encoding: 8bit\n\n")
+
+		body := strings.ReplaceAll(msg.Body, "\r\n", "\n")
+		for _, line := range strings.Split(body, "\n") {
+			// mboxrd: quote lines that would be read as a message separator
+			if strings.HasPrefix(strings.TrimLeft(line, ">"), "From ") {
+				buf.WriteString(">")
+			}
+			buf.WriteString(line)
+			buf.WriteString("\n")
+		}
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes()
+}
+
+// mboxHeader strips line breaks that would start new header fields
+func mboxHeader(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
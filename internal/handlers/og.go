@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+)
+
+// OGImageHandler serves OpenGraph share card images
+type OGImageHandler struct {
+	ogImageService services.OGImageService
+}
+
+func NewOGImageHandler(ogImageService services.OGImageService) *OGImageHandler {
+	return &OGImageHandler{
+		ogImageService: ogImageService,
+	}
+}
+
+// GetProjectCard handles GET /v1/og/projects/{id}.png
+func (h *OGImageHandler) GetProjectCard(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	// The route matches the whole "{id}.png" segment
+	file := c.Param("id")
+	idParam, ok := strings.CutSuffix(file, ".png")
+	if !ok {
+		response.NotFound(c, nil, "Image not found")
+		return
+	}
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Str("id", idParam).Msg("Invalid project ID")
+		response.BadRequest(c, err, "Invalid project ID")
+		return
+	}
+
+	data, err := h.ogImageService.ProjectCard(ctx, tenantID, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to get project OG image")
+		response.HandleError(c, err, "Failed to get project image")
+		return
+	}
+
+	c.Data(http.StatusOK, "image/png", data)
+}
+
+// GetProfileCard handles GET /v1/og/profile.png
+func (h *OGImageHandler) GetProfileCard(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	data, err := h.ogImageService.ProfileCard(ctx, tenantID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get profile OG image")
+		response.HandleError(c, err, "Failed to get profile image")
+		return
+	}
+
+	c.Data(http.StatusOK, "image/png", data)
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"portfolio-backend/internal/models"
+)
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+// parseListOptions reads the ?page= and ?per_page= query parameters shared by
+// paginated list endpoints, returning validation errors keyed by parameter
+func parseListOptions(c *gin.Context) (models.ListOptions, map[string]interface{}) {
+	opts := models.ListOptions{Page: 1, PerPage: defaultPerPage}
+	errors := make(map[string]interface{})
+
+	if value := c.Query("page"); value != "" {
+		page, err := strconv.Atoi(value)
+		if err != nil || page < 1 {
+			errors["page"] = "page must be a positive integer"
+		} else {
+			opts.Page = page
+		}
+	}
+
+	if value := c.Query("per_page"); value != "" {
+		perPage, err := strconv.Atoi(value)
+		if err != nil || perPage < 1 || perPage > maxPerPage {
+			errors["per_page"] = fmt.Sprintf("per_page must be between 1 and %d", maxPerPage)
+		} else {
+			opts.PerPage = perPage
+		}
+	}
+
+	if len(errors) > 0 {
+		return opts, errors
+	}
+	return opts, nil
+}
@@ -2,7 +2,7 @@ package handlers
 
 import (
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"portfolio-backend/internal/models"
 	"portfolio-backend/internal/services"
@@ -23,11 +23,12 @@ func NewProfileHandler(profileService services.ProfileService) *ProfileHandler {
 // GetProfile handles GET /v1/profile
 func (h *ProfileHandler) GetProfile(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
-	profile, err := h.profileService.GetProfile(ctx)
+	profile, err := h.profileService.GetProfile(ctx, tenantID)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get profile")
-		response.InternalServerError(c, err, "Failed to get profile")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get profile")
+		response.HandleError(c, err, "Failed to get profile")
 		return
 	}
 
@@ -37,10 +38,11 @@ func (h *ProfileHandler) GetProfile(c *gin.Context) {
 // UpdateProfile handles PUT /v1/profile
 func (h *ProfileHandler) UpdateProfile(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
 	var req models.UpdateProfileRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Warn().Err(err).Msg("Invalid request body")
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
 		response.BadRequest(c, err, "Invalid request body")
 		return
 	}
@@ -51,10 +53,10 @@ func (h *ProfileHandler) UpdateProfile(c *gin.Context) {
 		return
 	}
 
-	profile, err := h.profileService.UpdateProfile(ctx, req)
+	profile, err := h.profileService.UpdateProfile(ctx, tenantID, req)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to update profile")
-		response.InternalServerError(c, err, "Failed to update profile")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to update profile")
+		response.HandleError(c, err, "Failed to update profile")
 		return
 	}
 
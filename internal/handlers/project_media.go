@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+	"portfolio-backend/pkg/validator"
+)
+
+// ProjectMediaHandler manages project galleries on admin routes
+type ProjectMediaHandler struct {
+	mediaService services.ProjectMediaService
+}
+
+func NewProjectMediaHandler(mediaService services.ProjectMediaService) *ProjectMediaHandler {
+	return &ProjectMediaHandler{
+		mediaService: mediaService,
+	}
+}
+
+// AddMedia handles POST /v1/admin/projects/{id}/media
+func (h *ProjectMediaHandler) AddMedia(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	projectID, ok := parseEntryID(c, models.EntityProject)
+	if !ok {
+		return
+	}
+
+	var req models.AddProjectMediaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
+		response.BadRequest(c, err, "Invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(req); validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	item, err := h.mediaService.AddMedia(ctx, tenantID, projectID, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("project_id", projectID).Msg("Failed to add project media")
+		response.HandleError(c, err, "Failed to add project media")
+		return
+	}
+
+	response.Created(c, item, "Project media added successfully")
+}
+
+// ReorderMedia handles PUT /v1/admin/projects/{id}/media/order
+func (h *ProjectMediaHandler) ReorderMedia(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	projectID, ok := parseEntryID(c, models.EntityProject)
+	if !ok {
+		return
+	}
+
+	var req models.ReorderProjectMediaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
+		response.BadRequest(c, err, "Invalid request body")
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(req); validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	media, err := h.mediaService.ReorderMedia(ctx, tenantID, projectID, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("project_id", projectID).Msg("Failed to reorder project media")
+		response.HandleError(c, err, "Failed to reorder project media")
+		return
+	}
+
+	response.Success(c, media, "Project media reordered successfully")
+}
+
+// DeleteMedia handles DELETE /v1/admin/projects/{id}/media/{media_id}
+func (h *ProjectMediaHandler) DeleteMedia(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	projectID, ok := parseEntryID(c, models.EntityProject)
+	if !ok {
+		return
+	}
+
+	mediaParam := c.Param("media_id")
+	mediaID, err := strconv.Atoi(mediaParam)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Str("media_id", mediaParam).Msg("Invalid media ID")
+		response.BadRequest(c, err, "Invalid media ID")
+		return
+	}
+
+	if err := h.mediaService.DeleteMedia(ctx, tenantID, projectID, mediaID); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", mediaID).Msg("Failed to delete project media")
+		response.HandleError(c, err, "Failed to delete project media")
+		return
+	}
+
+	response.Success(c, nil, "Project media deleted successfully")
+}
@@ -5,10 +5,12 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
+	"portfolio-backend/internal/models"
 	"portfolio-backend/internal/services"
 	"portfolio-backend/pkg/response"
+	"portfolio-backend/pkg/validator"
 )
 
 type ProjectHandler struct {
@@ -24,6 +26,7 @@ func NewProjectHandler(projectService services.ProjectService) *ProjectHandler {
 // GetAllProjects handles GET /v1/projects
 func (h *ProjectHandler) GetAllProjects(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
 	// Check if featured filter is requested
 	featuredParam := c.Query("featured")
@@ -32,10 +35,15 @@ func (h *ProjectHandler) GetAllProjects(c *gin.Context) {
 		return
 	}
 
-	projects, err := h.projectService.GetAllProjects(ctx)
+	includeMedia, ok := parseIncludeMedia(c)
+	if !ok {
+		return
+	}
+
+	projects, err := h.projectService.GetAllProjects(ctx, tenantID, contentScope(c), includeMedia)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get projects")
-		response.InternalServerError(c, err, "Failed to get projects")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get projects")
+		response.HandleError(c, err, "Failed to get projects")
 		return
 	}
 
@@ -45,26 +53,20 @@ func (h *ProjectHandler) GetAllProjects(c *gin.Context) {
 // GetProjectByID handles GET /v1/projects/{id}
 func (h *ProjectHandler) GetProjectByID(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
 	idParam := c.Param("id")
 	id, err := strconv.Atoi(idParam)
 	if err != nil {
-		log.Warn().Str("id", idParam).Msg("Invalid project ID")
+		zerolog.Ctx(ctx).Warn().Str("id", idParam).Msg("Invalid project ID")
 		response.BadRequest(c, err, "Invalid project ID")
 		return
 	}
 
-	project, err := h.projectService.GetProjectByID(ctx, id)
+	project, err := h.projectService.GetProjectByID(ctx, tenantID, id, contentScope(c))
 	if err != nil {
-		log.Error().Err(err).Int("id", id).Msg("Failed to get project")
-		
-		// Check if it's a not found error
-		if strings.Contains(err.Error(), "not found") {
-			response.NotFound(c, err, "Project not found")
-			return
-		}
-		
-		response.InternalServerError(c, err, "Failed to get project")
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to get project")
+		response.HandleError(c, err, "Failed to get project")
 		return
 	}
 
@@ -74,13 +76,74 @@ func (h *ProjectHandler) GetProjectByID(c *gin.Context) {
 // GetFeaturedProjects handles GET /v1/projects?featured=true
 func (h *ProjectHandler) GetFeaturedProjects(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
-	projects, err := h.projectService.GetFeaturedProjects(ctx)
+	includeMedia, ok := parseIncludeMedia(c)
+	if !ok {
+		return
+	}
+
+	projects, err := h.projectService.GetFeaturedProjects(ctx, tenantID, contentScope(c), includeMedia)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get featured projects")
-		response.InternalServerError(c, err, "Failed to get featured projects")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get featured projects")
+		response.HandleError(c, err, "Failed to get featured projects")
 		return
 	}
 
 	response.Success(c, projects)
-}
\ No newline at end of file
+}
+// UpdateProject handles PUT /v1/projects/{id}
+func (h *ProjectHandler) UpdateProject(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		zerolog.Ctx(ctx).Warn().Str("id", idParam).Msg("Invalid project ID")
+		response.BadRequest(c, err, "Invalid project ID")
+		return
+	}
+
+	var req models.UpdateProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
+		response.BadRequest(c, err, "Invalid request body")
+		return
+	}
+
+	// Validate the request
+	if validationErrors := validator.ValidateStruct(req); validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	project, err := h.projectService.UpdateProject(ctx, tenantID, id, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to update project")
+		response.HandleError(c, err, "Failed to update project")
+		return
+	}
+
+	response.Success(c, project, "Project updated successfully")
+}
+
+// parseIncludeMedia reads the comma-separated ?include= parameter of project
+// lists, where "media" is the only supported value
+func parseIncludeMedia(c *gin.Context) (bool, bool) {
+	value := c.Query("include")
+	if value == "" {
+		return false, true
+	}
+
+	for _, name := range strings.Split(value, ",") {
+		if strings.TrimSpace(name) != "media" {
+			response.ValidationError(c, map[string]interface{}{
+				"include": "include must be media",
+			})
+			return false, false
+		}
+	}
+
+	return true, true
+}
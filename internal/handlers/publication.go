@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+	"portfolio-backend/pkg/validator"
+)
+
+// PublicationHandler changes the publication state of portfolio entries. Each
+// method returns the handler for one entity type addressed by :id.
+type PublicationHandler struct {
+	publicationService services.PublicationService
+}
+
+func NewPublicationHandler(publicationService services.PublicationService) *PublicationHandler {
+	return &PublicationHandler{
+		publicationService: publicationService,
+	}
+}
+
+// UpdatePublication handles PATCH /v1/admin/{collection}/{id}/publication
+func (h *PublicationHandler) UpdatePublication(entityType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		tenantID := currentTenantID(c)
+
+		id, ok := parseEntryID(c, entityType)
+		if !ok {
+			return
+		}
+
+		var req models.UpdatePublicationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			zerolog.Ctx(ctx).Warn().Err(err).Msg("Invalid request body")
+			response.BadRequest(c, err, "Invalid request body")
+			return
+		}
+
+		if validationErrors := validator.ValidateStruct(req); validationErrors != nil {
+			response.ValidationError(c, validationErrors)
+			return
+		}
+
+		publication, err := h.publicationService.SetPublication(ctx, tenantID, entityType, id, req)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to update publication")
+			response.HandleError(c, err, "Failed to update publication")
+			return
+		}
+
+		response.Success(c, publication, "Publication updated successfully")
+	}
+}
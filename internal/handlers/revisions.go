@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+)
+
+// RevisionHandler serves the revision history of profiles and projects. Each
+// method returns the handler for one entity type: profile routes address the
+// tenant's single profile, project routes carry the project ID in :id.
+type RevisionHandler struct {
+	revisionService services.RevisionService
+}
+
+func NewRevisionHandler(revisionService services.RevisionService) *RevisionHandler {
+	return &RevisionHandler{
+		revisionService: revisionService,
+	}
+}
+
+// ListRevisions handles GET /v1/admin/profile/revisions and /v1/admin/projects/{id}/revisions
+func (h *RevisionHandler) ListRevisions(entityType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		tenantID := currentTenantID(c)
+
+		entityID, ok := h.entityID(c, entityType)
+		if !ok {
+			return
+		}
+
+		opts, validationErrors := parseListOptions(c)
+		if validationErrors != nil {
+			response.ValidationError(c, validationErrors)
+			return
+		}
+
+		revisions, pagination, err := h.revisionService.ListRevisions(ctx, tenantID, entityType, entityID, opts)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to list revisions")
+			response.HandleError(c, err, "Failed to list revisions")
+			return
+		}
+
+		response.Paginated(c, revisions, pagination)
+	}
+}
+
+// GetRevision handles GET .../revisions/{revision}
+func (h *RevisionHandler) GetRevision(entityType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		tenantID := currentTenantID(c)
+
+		entityID, ok := h.entityID(c, entityType)
+		if !ok {
+			return
+		}
+		number, ok := parseRevisionNumber(c, c.Param("revision"), "revision")
+		if !ok {
+			return
+		}
+
+		revision, err := h.revisionService.GetRevision(ctx, tenantID, entityType, entityID, number)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("revision", number).Msg("Failed to get revision")
+			response.HandleError(c, err, "Failed to get revision")
+			return
+		}
+
+		response.Success(c, revision)
+	}
+}
+
+// DiffRevisions handles GET .../revisions/diff?from={revision}&to={revision}
+func (h *RevisionHandler) DiffRevisions(entityType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		tenantID := currentTenantID(c)
+
+		entityID, ok := h.entityID(c, entityType)
+		if !ok {
+			return
+		}
+		from, ok := parseRevisionNumber(c, c.Query("from"), "from")
+		if !ok {
+			return
+		}
+		to, ok := parseRevisionNumber(c, c.Query("to"), "to")
+		if !ok {
+			return
+		}
+
+		revisionDiff, err := h.revisionService.DiffRevisions(ctx, tenantID, entityType, entityID, from, to)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("from", from).Int("to", to).Msg("Failed to diff revisions")
+			response.HandleError(c, err, "Failed to diff revisions")
+			return
+		}
+
+		response.Success(c, revisionDiff)
+	}
+}
+
+// RestoreRevision handles POST .../revisions/{revision}/restore
+func (h *RevisionHandler) RestoreRevision(entityType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		tenantID := currentTenantID(c)
+
+		entityID, ok := h.entityID(c, entityType)
+		if !ok {
+			return
+		}
+		number, ok := parseRevisionNumber(c, c.Param("revision"), "revision")
+		if !ok {
+			return
+		}
+
+		restored, err := h.revisionService.RestoreRevision(ctx, tenantID, entityType, entityID, number)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("revision", number).Msg("Failed to restore revision")
+			response.HandleError(c, err, "Failed to restore revision")
+			return
+		}
+
+		response.Success(c, restored, "Revision restored successfully")
+	}
+}
+
+// entityID returns the ID of the entity addressed by the route, writing an
+// error response when it cannot be determined
+func (h *RevisionHandler) entityID(c *gin.Context, entityType string) (int, bool) {
+	if entityType == models.EntityProfile {
+		id, err := h.revisionService.ProfileID(c.Request.Context(), currentTenantID(c))
+		if err != nil {
+			zerolog.Ctx(c.Request.Context()).Error().Err(err).Msg("Failed to get profile")
+			response.HandleError(c, err, "Failed to get profile")
+			return 0, false
+		}
+		return id, true
+	}
+
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		zerolog.Ctx(c.Request.Context()).Warn().Str("id", idParam).Msgf("Invalid %s ID", entityType)
+		response.BadRequest(c, err, "Invalid "+entityType+" ID")
+		return 0, false
+	}
+	return id, true
+}
+
+// parseRevisionNumber parses a revision number, writing a validation error when invalid
+func parseRevisionNumber(c *gin.Context, value, name string) (int, bool) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		response.ValidationError(c, map[string]interface{}{
+			name: name + " must be a positive revision number",
+		})
+		return 0, false
+	}
+	return number, true
+}
@@ -2,34 +2,35 @@ package handlers
 
 import (
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
-	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/services"
 	"portfolio-backend/pkg/response"
 )
 
 type SkillsHandler struct {
-	skillRepo repositories.SkillRepository
+	skillService services.SkillService
 }
 
-func NewSkillsHandler(skillRepo repositories.SkillRepository) *SkillsHandler {
+func NewSkillsHandler(skillService services.SkillService) *SkillsHandler {
 	return &SkillsHandler{
-		skillRepo: skillRepo,
+		skillService: skillService,
 	}
 }
 
 // GetSkills handles GET /v1/skills
 func (h *SkillsHandler) GetSkills(c *gin.Context) {
 	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
 
 	// Check if client wants skills grouped by category
 	groupBy := c.Query("group_by")
 	
 	if groupBy == "category" {
-		categories, err := h.skillRepo.GetSkillsByCategory(ctx)
+		categories, err := h.skillService.GetSkillsByCategory(ctx, tenantID, contentScope(c))
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to get skills by category")
-			response.InternalServerError(c, err, "Failed to get skills")
+			zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get skills by category")
+			response.HandleError(c, err, "Failed to get skills")
 			return
 		}
 		response.Success(c, categories)
@@ -37,10 +38,10 @@ func (h *SkillsHandler) GetSkills(c *gin.Context) {
 	}
 
 	// Default: return all skills as a flat list
-	skills, err := h.skillRepo.GetAllSkills(ctx)
+	skills, err := h.skillService.GetAllSkills(ctx, tenantID, contentScope(c))
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get skills")
-		response.InternalServerError(c, err, "Failed to get skills")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get skills")
+		response.HandleError(c, err, "Failed to get skills")
 		return
 	}
 
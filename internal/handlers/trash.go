@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/services"
+	"portfolio-backend/pkg/response"
+)
+
+// TrashHandler soft-deletes portfolio entries and serves the admin trash
+type TrashHandler struct {
+	trashService services.TrashService
+}
+
+func NewTrashHandler(trashService services.TrashService) *TrashHandler {
+	return &TrashHandler{
+		trashService: trashService,
+	}
+}
+
+// DeleteEntry handles DELETE /v1/admin/{collection}/{id} for one entity type
+func (h *TrashHandler) DeleteEntry(entityType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		tenantID := currentTenantID(c)
+
+		id, ok := parseEntryID(c, entityType)
+		if !ok {
+			return
+		}
+
+		if err := h.trashService.DeleteEntry(ctx, tenantID, entityType, id); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to delete entry")
+			response.HandleError(c, err, "Failed to delete "+entityType)
+			return
+		}
+
+		response.Success(c, nil, "Moved to trash")
+	}
+}
+
+// ListTrash handles GET /v1/admin/trash
+func (h *TrashHandler) ListTrash(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+
+	opts, validationErrors := parseListOptions(c)
+	if validationErrors != nil {
+		response.ValidationError(c, validationErrors)
+		return
+	}
+
+	filter := models.TrashFilter{
+		EntityType:  c.Query("entity_type"),
+		ListOptions: opts,
+	}
+
+	items, pagination, err := h.trashService.ListTrash(ctx, tenantID, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to list trash")
+		response.HandleError(c, err, "Failed to list trash")
+		return
+	}
+
+	response.Paginated(c, items, pagination)
+}
+
+// RestoreEntry handles POST /v1/admin/trash/{entity_type}/{id}/restore
+func (h *TrashHandler) RestoreEntry(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+	entityType := c.Param("entity_type")
+
+	id, ok := parseEntryID(c, entityType)
+	if !ok {
+		return
+	}
+
+	if err := h.trashService.RestoreEntry(ctx, tenantID, entityType, id); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("entity_type", entityType).Int("id", id).Msg("Failed to restore entry")
+		response.HandleError(c, err, "Failed to restore entry")
+		return
+	}
+
+	response.Success(c, nil, "Restored from trash")
+}
+
+// PurgeEntry handles DELETE /v1/admin/trash/{entity_type}/{id}
+func (h *TrashHandler) PurgeEntry(c *gin.Context) {
+	ctx := c.Request.Context()
+	tenantID := currentTenantID(c)
+	entityType := c.Param("entity_type")
+
+	id, ok := parseEntryID(c, entityType)
+	if !ok {
+		return
+	}
+
+	if err := h.trashService.PurgeEntry(ctx, tenantID, entityType, id); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("entity_type", entityType).Int("id", id).Msg("Failed to purge entry")
+		response.HandleError(c, err, "Failed to purge entry")
+		return
+	}
+
+	response.Success(c, nil, "Permanently deleted")
+}
+
+// parseEntryID reads the :id parameter, responding with 400 if it is not an integer
+func parseEntryID(c *gin.Context, entityType string) (int, bool) {
+	idParam := c.Param("id")
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		zerolog.Ctx(c.Request.Context()).Warn().Str("id", idParam).Str("entity_type", entityType).Msg("Invalid entry ID")
+		response.BadRequest(c, err, "Invalid "+entityType+" ID")
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"portfolio-backend/internal/buildinfo"
+	"portfolio-backend/pkg/response"
+)
+
+type VersionHandler struct{}
+
+func NewVersionHandler() *VersionHandler {
+	return &VersionHandler{}
+}
+
+// GetVersion handles GET /v1/version
+func (h *VersionHandler) GetVersion(c *gin.Context) {
+	response.Success(c, buildinfo.Get())
+}
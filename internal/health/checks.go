@@ -0,0 +1,67 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/database/repositories"
+)
+
+// Database pings the database
+func Database(db *database.DB) func(ctx context.Context) error {
+	return db.Health
+}
+
+// Migrations fails while the schema is older than minVersion or a migration
+// failed halfway, as queries of this build would fail against it
+func Migrations(db *database.DB, minVersion int) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		version, dirty, err := db.MigrationVersion(ctx)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			return fmt.Errorf("migration %d is dirty", version)
+		}
+		if version < minVersion {
+			return fmt.Errorf("schema version %d is older than required version %d", version, minVersion)
+		}
+		return nil
+	}
+}
+
+// WritableDir fails when files cannot be created in dir
+func WritableDir(dir string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", dir, err)
+		}
+		f, err := os.CreateTemp(dir, ".health-*")
+		if err != nil {
+			return fmt.Errorf("%s is not writable: %w", dir, err)
+		}
+		f.Close()
+		return os.Remove(f.Name())
+	}
+}
+
+// OutboxLag fails when a pending email has been due for longer than maxLag,
+// which means the outbox worker is not keeping up or not running
+func OutboxLag(repo repositories.OutboxRepository, maxLag time.Duration) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		oldest, err := repo.OldestPending(ctx)
+		if err != nil {
+			return err
+		}
+		if oldest == nil {
+			return nil
+		}
+		if lag := time.Since(*oldest); lag > maxLag {
+			return fmt.Errorf("oldest pending email is %s overdue", lag.Round(time.Second))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,204 @@
+// Package health runs named component checks for the liveness, readiness
+// and startup probes. Each check has a timeout and its result is cached
+// briefly, so frequent probes from several orchestrators cost at most one
+// database round trip per check and cache period.
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Probe selects the checks that decide one probe endpoint
+type Probe string
+
+// Probes, following Kubernetes semantics
+const (
+	// Liveness fails only when the process must be restarted
+	Liveness Probe = "livez"
+	// Readiness fails while the instance cannot serve traffic
+	Readiness Probe = "readyz"
+	// Startup fails until the instance has started; it stays passing after
+	Startup Probe = "startupz"
+)
+
+// Report and check statuses
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded" // a non-critical check failed
+	StatusFailing  = "failing"
+)
+
+// Check is one named component check
+type Check struct {
+	Name string
+	// Probes lists the probes that run the check
+	Probes []Probe
+	// Critical checks fail the probe; others only degrade it
+	Critical bool
+	Run      func(ctx context.Context) error
+}
+
+// Result is the outcome of one check
+type Result struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	Critical  bool      `json:"critical"`
+	Error     string    `json:"error,omitempty"`
+	Duration  int64     `json:"duration_ms"`
+	CheckedAt time.Time `json:"checked_at"`
+
+	err error
+}
+
+// Err returns the error of a failed check
+func (r Result) Err() error {
+	return r.err
+}
+
+// Report is the outcome of a probe
+type Report struct {
+	Probe  Probe    `json:"probe"`
+	Status string   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// OK reports whether the probe passes; degraded probes pass
+func (r Report) OK() bool {
+	return r.Status != StatusFailing
+}
+
+// entry is a registered check with its cached result
+type entry struct {
+	check   Check
+	mu      sync.Mutex
+	result  Result
+	expires time.Time
+}
+
+// Registry holds the registered checks
+type Registry struct {
+	timeout  time.Duration
+	cacheTTL time.Duration
+
+	mu      sync.RWMutex
+	entries []*entry
+
+	// started latches once the startup probe has passed
+	started atomic.Bool
+}
+
+// NewRegistry creates a registry running each check with timeout and
+// reusing its result for cacheTTL
+func NewRegistry(timeout, cacheTTL time.Duration) *Registry {
+	return &Registry{timeout: timeout, cacheTTL: cacheTTL}
+}
+
+// Register adds a check
+func (r *Registry) Register(check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, &entry{check: check})
+}
+
+// Run runs the checks of a probe concurrently. Once the startup probe has
+// passed it passes without running its checks again.
+func (r *Registry) Run(ctx context.Context, probe Probe) Report {
+	if probe == Startup && r.started.Load() {
+		return Report{Probe: probe, Status: StatusOK, Checks: []Result{}}
+	}
+
+	r.mu.RLock()
+	var entries []*entry
+	for _, e := range r.entries {
+		if e.check.runsIn(probe) {
+			entries = append(entries, e)
+		}
+	}
+	r.mu.RUnlock()
+
+	results := make([]Result, len(entries))
+	var wg sync.WaitGroup
+	for i, e := range entries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = r.result(ctx, e)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Probe: probe, Status: StatusOK, Checks: results}
+	for _, result := range results {
+		if result.Status == StatusOK {
+			continue
+		}
+		if result.Critical {
+			report.Status = StatusFailing
+			break
+		}
+		report.Status = StatusDegraded
+	}
+
+	if probe == Startup && report.OK() {
+		r.started.Store(true)
+	}
+	return report
+}
+
+// result returns the cached result of a check, running it when expired.
+// Concurrent callers wait for a single run. The check is detached from the
+// caller's cancellation so an aborted probe does not cache a failure.
+func (r *Registry) result(ctx context.Context, e *entry) Result {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(e.expires) {
+		return e.result
+	}
+
+	checkCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.timeout)
+	defer cancel()
+
+	err := runCheck(checkCtx, e.check)
+	result := Result{
+		Name:      e.check.Name,
+		Status:    StatusOK,
+		Critical:  e.check.Critical,
+		Duration:  time.Since(now).Milliseconds(),
+		CheckedAt: now,
+		err:       err,
+	}
+	if err != nil {
+		result.Status = StatusFailing
+		result.Error = err.Error()
+	}
+
+	e.result = result
+	e.expires = now.Add(r.cacheTTL)
+	return result
+}
+
+// runCheck runs a check, turning a panic into a failure rather than letting
+// it crash the process from the probe goroutine
+func runCheck(ctx context.Context, check Check) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("check panicked: %v", v)
+		}
+	}()
+	return check.Run(ctx)
+}
+
+func (c Check) runsIn(probe Probe) bool {
+	for _, p := range c.Probes {
+		if p == probe {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the negotiated locale fallback chain
+func NewContext(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locales)
+}
+
+// FromContext returns the locale fallback chain stored in ctx, most preferred first
+func FromContext(ctx context.Context) []string {
+	locales, _ := ctx.Value(contextKey{}).([]string)
+	return locales
+}
+
+// Negotiate builds the locale fallback chain for a request. An explicit lang
+// parameter wins over Accept-Language; each tag falls back to its base language
+// (id-ID -> id), only supported locales are kept and the default locale always
+// ends the chain.
+func Negotiate(lang, acceptLanguage string, supported []string, defaultLocale string) []string {
+	var requested []string
+	if lang != "" {
+		requested = append(requested, lang)
+	}
+	requested = append(requested, parseAcceptLanguage(acceptLanguage)...)
+
+	supportedSet := make(map[string]string, len(supported))
+	for _, locale := range supported {
+		supportedSet[Normalize(locale)] = locale
+	}
+
+	var chain []string
+	seen := make(map[string]bool)
+	add := func(tag string) {
+		locale, ok := supportedSet[Normalize(tag)]
+		if ok && !seen[locale] {
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+
+	for _, tag := range requested {
+		add(tag)
+		if base, _, found := strings.Cut(tag, "-"); found {
+			add(base)
+		}
+	}
+
+	if !seen[defaultLocale] {
+		chain = append(chain, defaultLocale)
+	}
+
+	return chain
+}
+
+// Normalize lowercases a language tag and uses '-' as separator
+func Normalize(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+// parseAcceptLanguage returns the language tags of an Accept-Language header by descending quality
+func parseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weightedTag
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		tags = append(tags, weightedTag{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	result := make([]string, len(tags))
+	for i, t := range tags {
+		result[i] = t.tag
+	}
+	return result
+}
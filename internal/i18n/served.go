@@ -0,0 +1,47 @@
+package i18n
+
+import (
+	"context"
+	"sync"
+)
+
+type servedKey struct{}
+
+// Served records the locales of the content actually sent in a response.
+// A requested locale without translations falls back to the default one,
+// so Content-Language can only be known after localization.
+type Served struct {
+	mu      sync.Mutex
+	locales []string
+}
+
+// WithServed returns a copy of ctx carrying a new Served recorder
+func WithServed(ctx context.Context) (context.Context, *Served) {
+	served := &Served{}
+	return context.WithValue(ctx, servedKey{}, served), served
+}
+
+// RecordServed notes that content in locale was sent. It does nothing when
+// ctx carries no recorder.
+func RecordServed(ctx context.Context, locale string) {
+	served, ok := ctx.Value(servedKey{}).(*Served)
+	if !ok {
+		return
+	}
+
+	served.mu.Lock()
+	defer served.mu.Unlock()
+	for _, l := range served.locales {
+		if l == locale {
+			return
+		}
+	}
+	served.locales = append(served.locales, locale)
+}
+
+// Locales returns the recorded locales in the order they were first served
+func (s *Served) Locales() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.locales...)
+}
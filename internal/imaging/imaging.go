@@ -0,0 +1,149 @@
+// Package imaging validates uploaded images and renders the resized JPEG and
+// WebP variants that are served to visitors. Re-encoding drops all metadata,
+// so EXIF data (camera details, GPS position) never reaches the blob store.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // decoders for image.Decode
+	"image/jpeg"
+	_ "image/png"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// Widths are the variant widths rendered for each upload. Images narrower
+// than a width get one variant at their own width instead of being enlarged.
+var Widths = []int{320, 768, 1280, 1920}
+
+// jpegQuality balances size and quality for photos and screenshots
+const jpegQuality = 82
+
+// Variant formats
+const (
+	FormatJPEG = "jpeg"
+	FormatWebP = "webp"
+)
+
+// ErrUnsupportedFormat is returned for files that are not JPEG, PNG, GIF or WebP images
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+// ErrTooLarge is returned for images with more pixels than allowed
+var ErrTooLarge = errors.New("image dimensions too large")
+
+// supportedFormats lists the decoder names accepted for uploads
+var supportedFormats = map[string]bool{
+	"jpeg": true,
+	"png":  true,
+	"gif":  true,
+	"webp": true,
+}
+
+// Variant is one encoded rendition of an uploaded image
+type Variant struct {
+	Width       int
+	Height      int
+	Format      string
+	ContentType string
+	Data        []byte
+}
+
+// Process decodes an uploaded image, applies its EXIF orientation and renders
+// a JPEG and a WebP variant for each width in Widths. Images with more than
+// maxPixels pixels are rejected before they are decoded.
+func Process(data []byte, maxPixels int) ([]Variant, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || !supportedFormats[format] {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if format == "jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+
+	var variants []Variant
+	for _, width := range targetWidths(img.Bounds().Dx()) {
+		resized := resize(img, width)
+
+		jpegData, err := encodeJPEG(resized)
+		if err != nil {
+			return nil, err
+		}
+		webpData, err := encodeWebP(resized)
+		if err != nil {
+			return nil, err
+		}
+
+		bounds := resized.Bounds()
+		variants = append(variants,
+			Variant{Width: bounds.Dx(), Height: bounds.Dy(), Format: FormatJPEG, ContentType: "image/jpeg", Data: jpegData},
+			Variant{Width: bounds.Dx(), Height: bounds.Dy(), Format: FormatWebP, ContentType: "image/webp", Data: webpData},
+		)
+	}
+
+	return variants, nil
+}
+
+// targetWidths returns the variant widths for an image of the given width
+func targetWidths(width int) []int {
+	var widths []int
+	for _, w := range Widths {
+		if w >= width {
+			return append(widths, width)
+		}
+		widths = append(widths, w)
+	}
+	return widths
+}
+
+// resize scales img to width, keeping its aspect ratio
+func resize(img image.Image, width int) *image.NRGBA {
+	bounds := img.Bounds()
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	if width == bounds.Dx() && height == bounds.Dy() {
+		draw.Copy(dst, image.Point{}, img, bounds, draw.Src, nil)
+	} else {
+		draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	}
+	return dst
+}
+
+// encodeJPEG encodes img as a JPEG, flattening transparency onto white
+func encodeJPEG(img *image.NRGBA) ([]byte, error) {
+	flat := image.NewRGBA(img.Bounds())
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), img, img.Bounds().Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode JPEG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeWebP encodes img as a lossless WebP
+func encodeWebP(img *image.NRGBA) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := nativewebp.Encode(&buf, img, nil); err != nil {
+		return nil, fmt.Errorf("failed to encode WebP: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,49 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// LogMailer is a development sink that appends emails to a file, or logs
+// them when no path is configured. Nothing is delivered.
+type LogMailer struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewLogMailer creates a new log mailer
+func NewLogMailer(path string) *LogMailer {
+	return &LogMailer{path: path}
+}
+
+func (m *LogMailer) Send(ctx context.Context, email Email) error {
+	if m.path == "" {
+		zerolog.Ctx(ctx).Info().
+			Str("to", email.To).
+			Str("reply_to", email.ReplyTo).
+			Str("subject", email.Subject).
+			Str("body", email.Body).
+			Msg("Email captured by log mailer")
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open mail log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(buildMessage(email), "\r\n\r\n"...)); err != nil {
+		return fmt.Errorf("failed to write mail log: %w", err)
+	}
+
+	return nil
+}
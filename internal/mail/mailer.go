@@ -0,0 +1,35 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"portfolio-backend/internal/config"
+)
+
+// Email is a plain-text email ready for delivery
+type Email struct {
+	From    string
+	To      string
+	ReplyTo string
+	Subject string
+	Body    string
+	Headers map[string]string
+}
+
+// Mailer delivers emails. Implementations must be safe for concurrent use.
+type Mailer interface {
+	Send(ctx context.Context, email Email) error
+}
+
+// NewMailer creates the mailer selected by the configured driver
+func NewMailer(cfg *config.MailConfig) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTPMailer(cfg), nil
+	case "log", "":
+		return NewLogMailer(cfg.LogPath), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,144 @@
+package mail
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/correlation"
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+)
+
+// OutboxWorker periodically delivers pending outbox entries, retrying
+// failures with exponential backoff until MaxAttempts is reached
+type OutboxWorker struct {
+	repo   repositories.OutboxRepository
+	mailer Mailer
+	config config.MailConfig
+}
+
+// NewOutboxWorker creates a new outbox worker
+func NewOutboxWorker(repo repositories.OutboxRepository, mailer Mailer, cfg config.MailConfig) *OutboxWorker {
+	return &OutboxWorker{
+		repo:   repo,
+		mailer: mailer,
+		config: cfg,
+	}
+}
+
+// Run processes the outbox until ctx is cancelled
+func (w *OutboxWorker) Run(ctx context.Context) {
+	if w.config.OutboxInterval <= 0 {
+		log.Info().Msg("Mail outbox worker disabled")
+		return
+	}
+
+	ticker := time.NewTicker(w.config.OutboxInterval)
+	defer ticker.Stop()
+
+	log.Info().
+		Str("driver", w.config.Driver).
+		Dur("interval", w.config.OutboxInterval).
+		Msg("Mail outbox worker started")
+
+	for {
+		w.processBatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Mail outbox worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// processBatch claims and delivers one batch of due entries
+func (w *OutboxWorker) processBatch(ctx context.Context) {
+	// The lease outlives the send timeout so a crashed worker's entries are retried
+	lease := 2 * w.config.SendTimeout
+
+	entries, err := w.repo.ClaimPending(ctx, w.config.OutboxBatchSize, lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error().Err(err).Msg("Failed to claim outbox entries")
+		}
+		return
+	}
+
+	for _, entry := range entries {
+		w.deliver(ctx, entry)
+	}
+}
+
+// deliver sends one entry and records the outcome
+func (w *OutboxWorker) deliver(ctx context.Context, entry models.OutboxEntry) {
+	email := Email{
+		From:    w.config.From,
+		To:      entry.Recipient,
+		Subject: entry.Subject,
+		Body:    entry.Body,
+	}
+	if entry.ReplyTo != nil {
+		email.ReplyTo = *entry.ReplyTo
+	}
+
+	// Tie the delivery to the request that queued it
+	logger := log.With().Int("outbox_id", entry.ID).Logger()
+	if entry.CorrelationID != nil {
+		email.Headers = map[string]string{"X-Correlation-ID": *entry.CorrelationID}
+		logger = logger.With().Str("correlation_id", *entry.CorrelationID).Logger()
+		ctx = correlation.NewContext(ctx, *entry.CorrelationID)
+	}
+	ctx = logger.WithContext(ctx)
+
+	sendCtx, cancel := context.WithTimeout(ctx, w.config.SendTimeout)
+	err := w.mailer.Send(sendCtx, email)
+	cancel()
+
+	if err == nil {
+		if err := w.repo.MarkSent(ctx, entry.ID); err != nil {
+			logger.Error().Err(err).Msg("Failed to mark outbox entry as sent")
+			return
+		}
+		logger.Info().
+			Int("attempts", entry.Attempts).
+			Msg("Outbox email delivered")
+		return
+	}
+
+	final := entry.Attempts >= w.config.MaxAttempts
+	nextAttemptAt := time.Now().Add(backoff(entry.Attempts))
+
+	logEvent := logger.Warn()
+	if final {
+		logEvent = logger.Error()
+	}
+	logEvent.
+		Err(err).
+		Int("attempts", entry.Attempts).
+		Bool("final", final).
+		Time("next_attempt_at", nextAttemptAt).
+		Msg("Outbox email delivery failed")
+
+	if err := w.repo.MarkFailed(ctx, entry.ID, err.Error(), nextAttemptAt, final); err != nil {
+		logger.Error().Err(err).Msg("Failed to record outbox failure")
+	}
+}
+
+// backoff returns the delay before the next attempt: 30s, 1m, 2m, ... capped at 6h
+func backoff(attempts int) time.Duration {
+	const maxDelay = 6 * time.Hour
+
+	delay := 30 * time.Second
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return delay
+}
@@ -0,0 +1,137 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"portfolio-backend/internal/config"
+)
+
+// SMTPMailer delivers emails through an SMTP relay, upgrading to TLS with
+// STARTTLS when the server supports it
+type SMTPMailer struct {
+	addr     string
+	host     string
+	auth     smtp.Auth
+	from     string
+	dialTime time.Duration
+}
+
+// NewSMTPMailer creates a new SMTP mailer
+func NewSMTPMailer(cfg *config.MailConfig) *SMTPMailer {
+	var auth smtp.Auth
+	if cfg.SMTPUser != "" {
+		auth = smtp.PlainAuth("", cfg.SMTPUser, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+
+	return &SMTPMailer{
+		addr:     net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(cfg.SMTPPort)),
+		host:     cfg.SMTPHost,
+		auth:     auth,
+		from:     cfg.From,
+		dialTime: 10 * time.Second,
+	}
+}
+
+// Send delivers an email. The context bounds the whole SMTP conversation.
+func (m *SMTPMailer) Send(ctx context.Context, email Email) error {
+	if email.From == "" {
+		email.From = m.from
+	}
+
+	dialer := net.Dialer{Timeout: m.dialTime}
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	if m.auth != nil {
+		if err := client.Auth(m.auth); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(email.From); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+	if err := client.Rcpt(email.To); err != nil {
+		return fmt.Errorf("failed to set recipient: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start message body: %w", err)
+	}
+	if _, err := w.Write(buildMessage(email)); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage renders an RFC 5322 message with a UTF-8 plain-text body
+func buildMessage(email Email) []byte {
+	var buf bytes.Buffer
+
+	writeHeader := func(name, value string) {
+		buf.WriteString(name + ": " + sanitizeHeader(value) + "\r\n")
+	}
+
+	writeHeader("From", email.From)
+	writeHeader("To", email.To)
+	if email.ReplyTo != "" {
+		writeHeader("Reply-To", email.ReplyTo)
+	}
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	writeHeader("Date", time.Now().Format(time.RFC1123Z))
+	writeHeader("MIME-Version", "1.0")
+	writeHeader("Content-Type", "text/plain; charset=utf-8")
+	writeHeader("Content-Transfer-Encoding", "8bit")
+
+	names := make([]string, 0, len(email.Headers))
+	for name := range email.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeHeader(name, email.Headers[name])
+	}
+
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(email.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return buf.Bytes()
+}
+
+// sanitizeHeader strips line breaks so user input cannot inject headers
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
@@ -0,0 +1,161 @@
+// Package metrics collects the Prometheus metrics served on the admin
+// listener: HTTP traffic by route template, database pool statistics, rate
+// limiter counters, ETag revalidations, and build information.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"portfolio-backend/internal/buildinfo"
+	"portfolio-backend/internal/middleware"
+)
+
+const namespace = "portfolio"
+
+// unmatchedRoute labels requests that matched no route, so that scanners
+// probing random paths cannot inflate the number of series
+const unmatchedRoute = "unmatched"
+
+// Metrics owns the registry and the HTTP request metrics
+type Metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// New creates a registry with the Go runtime, process, build and HTTP metrics
+func New(build buildinfo.Info) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route template and status code.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method and route template.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+	}
+
+	buildInfo := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "build_info",
+		Help:      "Build information of the running binary; always 1.",
+		ConstLabels: prometheus.Labels{
+			"version":    build.Version,
+			"commit":     build.Commit,
+			"build_time": build.BuildTime,
+			"go_version": build.GoVersion,
+		},
+	})
+	buildInfo.Set(1)
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		buildInfo,
+		m.requests,
+		m.duration,
+	)
+	m.registerCache()
+
+	return m
+}
+
+// Handler serves the registry in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// Middleware records the count and latency of each request, labeled by the
+// gin route template (/v1/projects/:id) rather than the raw path
+func (m *Metrics) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		method := c.Request.Method
+
+		m.requests.WithLabelValues(method, route, strconv.Itoa(c.Writer.Status())).Inc()
+		m.duration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+	}
+}
+
+// RegisterDB exports the connection pool statistics of db (open, in-use and
+// idle connections, waits and wait duration)
+func (m *Metrics) RegisterDB(db *sql.DB) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, namespace))
+}
+
+// RegisterRateLimiter exports the rejections and tracked clients of a rate
+// limiter under the given limiter label
+func (m *Metrics) RegisterRateLimiter(name string, rl *middleware.RateLimiter) {
+	labels := prometheus.Labels{"limiter": name}
+
+	m.registry.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "rate_limit_rejections_total",
+			Help:        "Requests rejected by the rate limiter.",
+			ConstLabels: labels,
+		}, func() float64 {
+			return float64(rl.Stats().Rejected)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Name:        "rate_limit_clients",
+			Help:        "Clients currently tracked by the rate limiter.",
+			ConstLabels: labels,
+		}, func() float64 {
+			return float64(rl.Stats().Clients)
+		}),
+	)
+}
+
+// registerCache exports how many responses carried an ETag, how many of
+// them were answered with 304 Not Modified, and the ratio of the two
+func (m *Metrics) registerCache() {
+	m.registry.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "etag_responses_total",
+			Help:      "GET responses sent with an ETag.",
+		}, func() float64 {
+			return float64(middleware.ReadCacheStats().Revalidatable)
+		}),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "etag_not_modified_total",
+			Help:      "GET responses with an ETag answered with 304 Not Modified.",
+		}, func() float64 {
+			return float64(middleware.ReadCacheStats().NotModified)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "etag_revalidation_ratio",
+			Help:      "Share of GET responses with an ETag answered with 304 since startup.",
+		}, func() float64 {
+			stats := middleware.ReadCacheStats()
+			if stats.Revalidatable > 0 {
+				return float64(stats.NotModified) / float64(stats.Revalidatable)
+			}
+			return 0
+		}),
+	)
+}
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/auth"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/tenant"
+	"portfolio-backend/pkg/response"
+)
+
+// Authenticate stores the principal of a valid admin bearer token in the
+// request context. Anonymous requests pass through; invalid tokens, and
+// tokens of another tenant than the one resolved by Tenant, are rejected so
+// they never unmask or bypass anything on a foreign portfolio.
+func Authenticate(authenticator *auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := bearerToken(c)
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		principal, ok := authenticator.Authenticate(token)
+		if !ok {
+			c.Header("WWW-Authenticate", `Bearer realm="admin", error="invalid_token"`)
+			response.Unauthorized(c, errors.New("invalid admin token"), "A valid admin token is required")
+			c.Abort()
+			return
+		}
+
+		if t, ok := tenant.FromContext(c.Request.Context()); ok && !principal.Administers(t.Slug) {
+			rejectForeignTenant(c, principal, t.Slug)
+			return
+		}
+
+		c.Set("actor", principal.Actor)
+		c.Request = c.Request.WithContext(auth.NewPrincipalContext(c.Request.Context(), principal))
+		addLogFields(c, func(l zerolog.Context) zerolog.Context {
+			return l.Str("user", principal.Actor)
+		})
+
+		c.Next()
+	}
+}
+
+// RequireAdmin rejects requests that Authenticate did not identify, and
+// principals that do not administer the resolved tenant
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			c.Header("WWW-Authenticate", `Bearer realm="admin"`)
+			response.Unauthorized(c, errors.New("missing admin token"), "A valid admin token is required")
+			c.Abort()
+			return
+		}
+
+		t, ok := tenant.FromContext(ctx)
+		if !ok || !principal.Administers(t.Slug) {
+			slug := ""
+			if t != nil {
+				slug = t.Slug
+			}
+			rejectForeignTenant(c, principal, slug)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// rejectForeignTenant answers 403 for a valid token used on a portfolio it
+// does not administer
+func rejectForeignTenant(c *gin.Context, principal auth.Principal, slug string) {
+	zerolog.Ctx(c.Request.Context()).Warn().
+		Str("user", principal.Actor).
+		Str("token_tenant", principal.Tenant).
+		Str("tenant", slug).
+		Msg("Admin token used on another tenant")
+	response.Forbidden(c, fmt.Errorf("admin token of %q used on tenant %q", principal.Tenant, slug),
+		"This admin token is not valid for this portfolio")
+	c.Abort()
+}
+
+// IncludeUnpublished widens the content scope of the following handlers to
+// drafts, scheduled and archived entries. It belongs behind RequireAdmin.
+func IncludeUnpublished() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("scope", models.ScopeAll)
+		c.Next()
+	}
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header
+func bearerToken(c *gin.Context) string {
+	scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
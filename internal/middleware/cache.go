@@ -1,12 +1,17 @@
 package middleware
 
 import (
+	"bytes"
 	"crypto/md5"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"portfolio-backend/internal/auth"
 )
 
 // CacheConfig holds caching configuration
@@ -16,6 +21,26 @@ type CacheConfig struct {
 	ETagEnable bool // Whether to generate ETags
 }
 
+// CacheStats reports how often clients revalidate with a current ETag
+type CacheStats struct {
+	Revalidatable uint64 // Responses sent with an ETag since startup
+	NotModified   uint64 // Of those, answered with 304 Not Modified
+}
+
+// cacheStats counts the responses of every Cache middleware
+var cacheStats struct {
+	revalidatable atomic.Uint64
+	notModified   atomic.Uint64
+}
+
+// ReadCacheStats returns the ETag revalidation counters of all Cache middlewares
+func ReadCacheStats() CacheStats {
+	return CacheStats{
+		Revalidatable: cacheStats.revalidatable.Load(),
+		NotModified:   cacheStats.notModified.Load(),
+	}
+}
+
 // Cache returns a middleware that adds caching headers
 func Cache(config CacheConfig) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -33,81 +58,133 @@ func Cache(config CacheConfig) gin.HandlerFunc {
 			} else {
 				cacheControl = fmt.Sprintf("private, max-age=%d", config.MaxAge)
 			}
+		} else if config.Public {
+			cacheControl = "public, no-cache"
+		}
+		// Authenticated responses may contain unmasked personal data
+		if _, ok := auth.ActorFromContext(c.Request.Context()); ok {
+			cacheControl = "private, no-store"
 		}
 		c.Header("Cache-Control", cacheControl)
 
 		// Add Last-Modified header (current time for dynamic content)
 		c.Header("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
 
-		// Check if client sent If-None-Match header for ETag validation
-		if config.ETagEnable {
-			clientETag := c.GetHeader("If-None-Match")
-			
-			// Store original writer
+		// Buffer the body so its hash can be sent as ETag and a matching
+		// If-None-Match answered with 304. Private no-store responses are
+		// never revalidated.
+		if config.ETagEnable && cacheControl != "private, no-store" {
 			writer := &etagResponseWriter{
 				ResponseWriter: c.Writer,
-				clientETag:     clientETag,
-				context:        c,
+				status:         http.StatusOK,
 			}
 			c.Writer = writer
+			defer func() { c.Writer = writer.ResponseWriter }()
+
+			c.Next()
+			writer.flush(c.GetHeader("If-None-Match"))
+			return
 		}
 
 		c.Next()
 	}
 }
 
-// etagResponseWriter wraps gin.ResponseWriter to generate ETags
+// etagResponseWriter buffers the response until the handler is done, so
+// the ETag header can be derived from the complete body
 type etagResponseWriter struct {
 	gin.ResponseWriter
-	clientETag string
-	context    *gin.Context
-	body       []byte
+	status int
+	body   bytes.Buffer
+}
+
+func (w *etagResponseWriter) WriteHeader(statusCode int) {
+	w.status = statusCode
 }
 
+func (w *etagResponseWriter) WriteHeaderNow() {}
+
 func (w *etagResponseWriter) Write(data []byte) (int, error) {
-	w.body = append(w.body, data...)
-	return len(data), nil
+	return w.body.Write(data)
 }
 
 func (w *etagResponseWriter) WriteString(s string) (int, error) {
-	w.body = append(w.body, []byte(s)...)
-	return len(s), nil
+	return w.body.WriteString(s)
 }
 
-func (w *etagResponseWriter) WriteHeader(statusCode int) {
-	// Generate ETag from response body hash
-	if len(w.body) > 0 && statusCode == http.StatusOK {
-		etag := fmt.Sprintf(`"%x"`, md5.Sum(w.body))
+func (w *etagResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *etagResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *etagResponseWriter) Written() bool {
+	return false
+}
+
+// flush sends the buffered response, or 304 without a body when
+// ifNoneMatch names the ETag of a successful response
+func (w *etagResponseWriter) flush(ifNoneMatch string) {
+	if w.status == http.StatusOK && w.body.Len() > 0 {
+		etag := fmt.Sprintf(`"%x"`, md5.Sum(w.body.Bytes()))
 		w.ResponseWriter.Header().Set("ETag", etag)
-		
-		// Check if client ETag matches
-		if w.clientETag == etag {
+		cacheStats.revalidatable.Add(1)
+
+		if etagMatches(ifNoneMatch, etag) {
+			cacheStats.notModified.Add(1)
 			w.ResponseWriter.WriteHeader(http.StatusNotModified)
+			w.ResponseWriter.WriteHeaderNow()
 			return
 		}
 	}
-	
-	w.ResponseWriter.WriteHeader(statusCode)
-	if len(w.body) > 0 {
-		_, _ = w.ResponseWriter.Write(w.body)
+
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.WriteHeaderNow()
+	if w.body.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+	}
+}
+
+// etagMatches applies the weak comparison of If-None-Match: any listed
+// tag, with or without the W/ prefix, or "*" matches
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
 	}
+	return false
 }
 
 // DefaultCacheConfig returns a reasonable default caching configuration
 func DefaultCacheConfig() CacheConfig {
 	return CacheConfig{
-		MaxAge:     300,   // 5 minutes
-		Public:     true,  // Allow CDN caching
-		ETagEnable: false, // Disable ETag for now
+		MaxAge:     300,  // 5 minutes
+		Public:     true, // Allow CDN caching
+		ETagEnable: true, // Revalidate with If-None-Match
 	}
 }
 
 // LongCacheConfig returns configuration for long-term caching (for static-like data)
 func LongCacheConfig() CacheConfig {
 	return CacheConfig{
-		MaxAge:     3600,  // 1 hour
-		Public:     true,  // Allow CDN caching
-		ETagEnable: false, // Disable ETag for now
+		MaxAge:     3600, // 1 hour
+		Public:     true, // Allow CDN caching
+		ETagEnable: true, // Revalidate with If-None-Match
+	}
+}
+
+// RevalidateCacheConfig returns configuration for content that can change
+// without a request, such as entries published or archived by the scheduler:
+// caches may store it but must revalidate every copy before reuse
+func RevalidateCacheConfig() CacheConfig {
+	return CacheConfig{
+		MaxAge:     0,    // Revalidate on every use
+		Public:     true, // Allow CDN caching
+		ETagEnable: true, // Revalidate with If-None-Match
 	}
 }
 
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/i18n"
+)
+
+// Locale negotiates the response language from ?lang= or Accept-Language and
+// stores the fallback chain in the request context. Vary is set so caches
+// keep one entry per language, and Content-Language names the locales the
+// localized content was actually served in.
+func Locale(i18nConfig *config.I18nConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locales := i18n.Negotiate(
+			c.Query("lang"),
+			c.GetHeader("Accept-Language"),
+			i18nConfig.SupportedLocales,
+			i18nConfig.DefaultLocale,
+		)
+
+		ctx, served := i18n.WithServed(i18n.NewContext(c.Request.Context(), locales))
+		c.Request = c.Request.WithContext(ctx)
+		c.Writer = &contentLanguageWriter{ResponseWriter: c.Writer, served: served}
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		c.Next()
+	}
+}
+
+// contentLanguageWriter sets Content-Language from the served locales just
+// before the headers are written, after the handler has localized the body
+type contentLanguageWriter struct {
+	gin.ResponseWriter
+	served *i18n.Served
+	done   bool
+}
+
+func (w *contentLanguageWriter) setContentLanguage() {
+	if w.done || w.ResponseWriter.Written() {
+		return
+	}
+	w.done = true
+
+	if locales := w.served.Locales(); len(locales) > 0 {
+		w.Header().Set("Content-Language", strings.Join(locales, ", "))
+	}
+}
+
+func (w *contentLanguageWriter) WriteHeaderNow() {
+	w.setContentLanguage()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *contentLanguageWriter) Write(data []byte) (int, error) {
+	w.setContentLanguage()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *contentLanguageWriter) WriteString(s string) (int, error) {
+	w.setContentLanguage()
+	return w.ResponseWriter.WriteString(s)
+}
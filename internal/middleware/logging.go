@@ -1,15 +1,24 @@
 package middleware
 
 import (
+	"math/rand/v2"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/correlation"
+	"portfolio-backend/internal/redact"
 )
 
-// RequestLogger creates a Zerolog-based logging middleware
-func RequestLogger() gin.HandlerFunc {
+// RequestLogger creates a Zerolog-based logging middleware. Query strings,
+// headers and client IPs are redacted, requests are sampled per status class
+// and slow requests are logged at warn with their headers.
+func RequestLogger(cfg *config.LoggingConfig) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
 		path := c.Request.URL.Path
@@ -20,29 +29,30 @@ func RequestLogger() gin.HandlerFunc {
 
 		// Calculate latency
 		latency := time.Since(start)
+		statusCode := c.Writer.Status()
 
-		// Get client IP
-		clientIP := c.ClientIP()
-
-		// Get user agent
-		userAgent := c.Request.UserAgent()
+		slow := cfg.SlowRequestThreshold > 0 && latency >= cfg.SlowRequestThreshold
+		if !slow && !sampled(cfg, statusCode) {
+			return
+		}
 
 		// Build path with query params
 		if raw != "" {
-			path = path + "?" + raw
+			path = path + "?" + redact.Query(raw)
 		}
 
 		// Choose log level based on status code
 		var logEvent *zerolog.Event
-		statusCode := c.Writer.Status()
-		
+
+		// The request logger carries the fields added by later middleware
+		logger := zerolog.Ctx(c.Request.Context())
 		switch {
 		case statusCode >= 500:
-			logEvent = log.Error()
-		case statusCode >= 400:
-			logEvent = log.Warn()
+			logEvent = logger.Error()
+		case statusCode >= 400 || slow:
+			logEvent = logger.Warn()
 		default:
-			logEvent = log.Info()
+			logEvent = logger.Info()
 		}
 
 		logEvent.
@@ -50,37 +60,104 @@ func RequestLogger() gin.HandlerFunc {
 			Str("path", path).
 			Int("status", statusCode).
 			Dur("latency", latency).
-			Str("client_ip", clientIP).
-			Str("user_agent", userAgent).
-			Int("body_size", c.Writer.Size()).
-			Msg("HTTP Request")
+			Str("client_ip", redact.IP(c.ClientIP())).
+			Str("user_agent", redact.Header("User-Agent", c.Request.UserAgent())).
+			Int("body_size", c.Writer.Size())
+
+		if slow {
+			logEvent.
+				Dur("slow_request_threshold", cfg.SlowRequestThreshold).
+				Int64("request_size", c.Request.ContentLength).
+				Interface("headers", redact.Headers(c.Request.Header)).
+				Msg("Slow HTTP Request")
+			return
+		}
+		logEvent.Msg("HTTP Request")
+	}
+}
+
+// sampled decides whether a request with the given status is logged
+func sampled(cfg *config.LoggingConfig, statusCode int) bool {
+	var ratio float64
+	switch {
+	case statusCode >= 500:
+		ratio = cfg.Sample5xx
+	case statusCode >= 400:
+		ratio = cfg.Sample4xx
+	case statusCode >= 300:
+		ratio = cfg.Sample3xx
+	default:
+		ratio = cfg.Sample2xx
 	}
+	return ratio >= 1 || rand.Float64() < ratio
 }
 
-// CorrelationID middleware adds a correlation ID to each request
+// CorrelationID middleware adds a correlation ID to each request: the
+// caller's X-Correlation-ID or X-Request-ID if valid, else the trace ID of
+// the request span, else a new UUIDv7
 func CorrelationID() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		correlationID := c.GetHeader("X-Correlation-ID")
+		spanContext := trace.SpanContextFromContext(c.Request.Context())
+
+		correlationID, rejected := inboundCorrelationID(c)
+		if correlationID == "" && spanContext.IsValid() {
+			// Reuse the trace ID (from traceparent, or the new root span) so
+			// logs and traces share one identifier
+			correlationID = spanContext.TraceID().String()
+		}
 		if correlationID == "" {
-			correlationID = generateCorrelationID()
+			correlationID = correlation.New()
 		}
 
 		// Add correlation ID to context
 		c.Set("correlation_id", correlationID)
+		c.Request = c.Request.WithContext(correlation.NewContext(c.Request.Context(), correlationID))
 		
 		// Add correlation ID to response header
 		c.Header("X-Correlation-ID", correlationID)
 
-		// Update logger context
-		logger := log.With().Str("correlation_id", correlationID).Logger()
-		c.Set("logger", &logger)
+		// Carry a request logger in the context for handlers and services
+		logCtx := log.With().Str("correlation_id", correlationID)
+		if route := c.FullPath(); route != "" {
+			logCtx = logCtx.Str("route", route)
+		}
+		if spanContext.IsValid() {
+			logCtx = logCtx.
+				Str("trace_id", spanContext.TraceID().String()).
+				Str("span_id", spanContext.SpanID().String())
+		}
+		logger := logCtx.Logger()
+		c.Request = c.Request.WithContext(logger.WithContext(c.Request.Context()))
+
+		if rejected != "" {
+			logger.Debug().
+				Int("length", len(rejected)).
+				Msg("Ignoring invalid inbound correlation ID")
+		}
 
 		c.Next()
 	}
 }
 
-// generateCorrelationID generates a simple correlation ID
-func generateCorrelationID() string {
-	// Simple implementation - in production, use UUID or similar
-	return time.Now().Format("20060102150405") + "-" + string(rune(time.Now().Nanosecond()%1000))
-}
\ No newline at end of file
+// addLogFields replaces the request logger with one carrying extra fields
+func addLogFields(c *gin.Context, fields func(zerolog.Context) zerolog.Context) {
+	ctx := c.Request.Context()
+	logger := fields(zerolog.Ctx(ctx).With()).Logger()
+	c.Request = c.Request.WithContext(logger.WithContext(ctx))
+}
+
+// inboundCorrelationID returns the caller's X-Correlation-ID (or
+// X-Request-ID) if it is valid. An invalid ID is returned as rejected.
+func inboundCorrelationID(c *gin.Context) (id, rejected string) {
+	for _, header := range []string{"X-Correlation-ID", "X-Request-ID"} {
+		value := c.GetHeader(header)
+		if value == "" {
+			continue
+		}
+		if correlation.Valid(value) {
+			return value, ""
+		}
+		return "", value
+	}
+	return "", ""
+}
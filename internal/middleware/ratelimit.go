@@ -1,13 +1,16 @@
 package middleware
 
 import (
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 	"golang.org/x/time/rate"
 
+	"portfolio-backend/internal/redact"
 	"portfolio-backend/pkg/response"
 )
 
@@ -16,6 +19,7 @@ type RateLimitConfig struct {
 	RequestsPerSecond int           // Number of requests per second allowed
 	BurstSize         int           // Maximum burst size
 	CleanupInterval   time.Duration // How often to clean up expired limiters
+	QuotaPeriod       time.Duration // When set, allow BurstSize requests per period instead
 }
 
 // ClientLimiter holds rate limiter for a specific client
@@ -26,9 +30,23 @@ type ClientLimiter struct {
 
 // RateLimiter manages rate limiting for multiple clients
 type RateLimiter struct {
-	clients map[string]*ClientLimiter
-	mu      sync.RWMutex
-	config  RateLimitConfig
+	clients  map[string]*ClientLimiter
+	mu       sync.RWMutex
+	config   RateLimitConfig
+	rejected atomic.Uint64
+}
+
+// RateLimiterStats reports the state of a rate limiter
+type RateLimiterStats struct {
+	Clients  int    // Clients currently tracked
+	Rejected uint64 // Requests rejected since startup
+}
+
+// ClientStats describes one client tracked by a rate limiter
+type ClientStats struct {
+	ClientID string    `json:"client_id"`
+	Tokens   float64   `json:"tokens"` // Requests available right now
+	LastSeen time.Time `json:"last_seen"`
 }
 
 // NewRateLimiter creates a new rate limiter instance
@@ -50,8 +68,9 @@ func (rl *RateLimiter) RateLimit() gin.HandlerFunc {
 		clientID := rl.getClientID(c)
 		
 		if !rl.allow(clientID) {
-			log.Warn().
-				Str("client_id", clientID).
+			rl.rejected.Add(1)
+			zerolog.Ctx(c.Request.Context()).Warn().
+				Str("client_id", redact.IP(clientID)).
 				Str("path", c.Request.URL.Path).
 				Str("method", c.Request.Method).
 				Msg("Rate limit exceeded")
@@ -65,18 +84,46 @@ func (rl *RateLimiter) RateLimit() gin.HandlerFunc {
 	}
 }
 
-// getClientID extracts client identifier (IP address for now)
-func (rl *RateLimiter) getClientID(c *gin.Context) string {
-	// Try to get real IP from headers (in case of proxy/load balancer)
-	if xRealIP := c.GetHeader("X-Real-IP"); xRealIP != "" {
-		return xRealIP
-	}
-	if xForwardedFor := c.GetHeader("X-Forwarded-For"); xForwardedFor != "" {
-		return xForwardedFor
+// Stats returns the number of tracked clients and rejected requests
+func (rl *RateLimiter) Stats() RateLimiterStats {
+	rl.mu.RLock()
+	clients := len(rl.clients)
+	rl.mu.RUnlock()
+
+	return RateLimiterStats{
+		Clients:  clients,
+		Rejected: rl.rejected.Load(),
 	}
+}
+
+// getClientID extracts client identifier (IP address for now). Forwarding
+// headers only count when they come from a proxy in TRUSTED_PROXIES, so
+// clients cannot pick their own identity.
+func (rl *RateLimiter) getClientID(c *gin.Context) string {
 	return c.ClientIP()
 }
 
+// Clients returns the tracked clients, most recently seen first
+func (rl *RateLimiter) Clients() []ClientStats {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	now := time.Now()
+	clients := make([]ClientStats, 0, len(rl.clients))
+	for clientID, limiter := range rl.clients {
+		clients = append(clients, ClientStats{
+			ClientID: clientID,
+			Tokens:   limiter.limiter.TokensAt(now),
+			LastSeen: limiter.lastSeen,
+		})
+	}
+
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].LastSeen.After(clients[j].LastSeen)
+	})
+	return clients
+}
+
 // allow checks if the client is allowed to make a request
 func (rl *RateLimiter) allow(clientID string) bool {
 	rl.mu.Lock()
@@ -86,7 +133,7 @@ func (rl *RateLimiter) allow(clientID string) bool {
 	limiter, exists := rl.clients[clientID]
 	if !exists {
 		limiter = &ClientLimiter{
-			limiter:  rate.NewLimiter(rate.Limit(rl.config.RequestsPerSecond), rl.config.BurstSize),
+			limiter:  rate.NewLimiter(rl.limit(), rl.config.BurstSize),
 			lastSeen: time.Now(),
 		}
 		rl.clients[clientID] = limiter
@@ -97,6 +144,14 @@ func (rl *RateLimiter) allow(clientID string) bool {
 	return limiter.limiter.Allow()
 }
 
+// limit returns the token refill rate for new client limiters
+func (rl *RateLimiter) limit() rate.Limit {
+	if rl.config.QuotaPeriod > 0 && rl.config.BurstSize > 0 {
+		return rate.Every(rl.config.QuotaPeriod / time.Duration(rl.config.BurstSize))
+	}
+	return rate.Limit(rl.config.RequestsPerSecond)
+}
+
 // cleanupExpiredClients removes limiters that haven't been used recently
 func (rl *RateLimiter) cleanupExpiredClients() {
 	ticker := time.NewTicker(rl.config.CleanupInterval)
@@ -105,7 +160,12 @@ func (rl *RateLimiter) cleanupExpiredClients() {
 	for range ticker.C {
 		rl.mu.Lock()
 		
-		cutoff := time.Now().Add(-rl.config.CleanupInterval * 2)
+		// Keep quota limiters for a full period so waiting does not reset the quota
+		retention := rl.config.CleanupInterval * 2
+		if rl.config.QuotaPeriod > retention {
+			retention = rl.config.QuotaPeriod
+		}
+		cutoff := time.Now().Add(-retention)
 		for clientID, limiter := range rl.clients {
 			if limiter.lastSeen.Before(cutoff) {
 				delete(rl.clients, clientID)
@@ -1,13 +1,14 @@
 package middleware
 
 import (
-	"net/http"
+	"fmt"
 	"runtime/debug"
 
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
-	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/redact"
+	"portfolio-backend/pkg/response"
 )
 
 // Recovery returns a recovery middleware that recovers from panics
@@ -18,22 +19,17 @@ func Recovery() gin.HandlerFunc {
 				// Log the panic with stack trace
 				stack := debug.Stack()
 				
-				log.Error().
+				zerolog.Ctx(c.Request.Context()).Error().
 					Interface("panic", err).
 					Str("path", c.Request.URL.Path).
 					Str("method", c.Request.Method).
-					Str("client_ip", c.ClientIP()).
-					Str("user_agent", c.Request.UserAgent()).
+					Str("client_ip", redact.IP(c.ClientIP())).
+					Str("user_agent", redact.Header("User-Agent", c.Request.UserAgent())).
 					Bytes("stack", stack).
 					Msg("Panic recovered")
 
 				// Return error response
-				errorResponse := models.APIError{
-					Error:   "internal_server_error",
-					Message: "An unexpected error occurred",
-				}
-
-				c.JSON(http.StatusInternalServerError, errorResponse)
+				response.InternalServerError(c, fmt.Errorf("panic: %v", err), "An unexpected error occurred")
 				c.Abort()
 			}
 		}()
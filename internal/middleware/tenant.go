@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/tenant"
+	"portfolio-backend/pkg/response"
+)
+
+// Tenant resolves the tenant for a request from the :slug route parameter,
+// falling back to the request host, and stores it in the request context
+func Tenant(resolver *tenant.Resolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		var t *models.Tenant
+		var err error
+		if slug := c.Param("slug"); slug != "" {
+			t, err = resolver.BySlug(ctx, slug)
+		} else {
+			t, err = resolver.ByHost(ctx, c.Request.Host)
+		}
+
+		if err != nil {
+			zerolog.Ctx(ctx).Warn().
+				Err(err).
+				Str("host", c.Request.Host).
+				Str("slug", c.Param("slug")).
+				Msg("Failed to resolve tenant")
+			response.HandleError(c, err, "Failed to resolve portfolio")
+			c.Abort()
+			return
+		}
+
+		c.Set("tenant_id", t.ID)
+		c.Request = c.Request.WithContext(tenant.NewContext(ctx, t))
+		addLogFields(c, func(l zerolog.Context) zerolog.Context {
+			return l.Int("tenant_id", t.ID).Str("tenant", t.Slug)
+		})
+
+		c.Next()
+	}
+}
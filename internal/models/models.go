@@ -1,19 +1,23 @@
 package models
 
 import (
+	"encoding/xml"
+	"sort"
 	"time"
 )
 
 // Profile represents the user's profile information
 type Profile struct {
-	Name      string    `json:"name" db:"name" validate:"required,min=2,max=100"`
-	Title     string    `json:"title" db:"title" validate:"required,min=2,max=200"`
-	Location  string    `json:"location" db:"location" validate:"required,min=2,max=100"`
-	Email     string    `json:"email" db:"email" validate:"required,email"`
-	Phone     *string   `json:"phone,omitempty" db:"phone" validate:"omitempty,min=10,max=20"`
-	LinkedIn  *string   `json:"linkedin,omitempty" db:"linkedin" validate:"omitempty,url"`
-	Summary   string    `json:"summary" db:"summary" validate:"required,min=10,max=1000"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	XMLName   xml.Name  `json:"-" xml:"profile"`
+	ID        int       `json:"-" xml:"-" db:"id"`
+	Name      string    `json:"name" xml:"name" db:"name" validate:"required,min=2,max=100"`
+	Title     string    `json:"title" xml:"title" db:"title" validate:"required,min=2,max=200"`
+	Location  string    `json:"location" xml:"location" db:"location" validate:"required,min=2,max=100"`
+	Email     string    `json:"email,omitempty" xml:"email,omitempty" db:"email" validate:"required,email"`
+	Phone     *string   `json:"phone,omitempty" xml:"phone,omitempty" db:"phone" validate:"omitempty,min=10,max=20"`
+	LinkedIn  *string   `json:"linkedin,omitempty" xml:"linkedin,omitempty" db:"linkedin" validate:"omitempty,url"`
+	Summary   string    `json:"summary" xml:"summary" db:"summary" validate:"required,min=10,max=1000"`
+	UpdatedAt time.Time `json:"updated_at" xml:"updated_at" db:"updated_at"`
 }
 
 // UpdateProfileRequest represents the request payload for updating profile
@@ -29,101 +33,505 @@ type UpdateProfileRequest struct {
 
 // Experience represents work experience
 type Experience struct {
-	ID          int       `json:"id" db:"id"`
-	Company     string    `json:"company" db:"company" validate:"required,min=2,max=100"`
-	Position    string    `json:"position" db:"position" validate:"required,min=2,max=100"`
-	StartDate   time.Time `json:"start_date" db:"start_date" validate:"required"`
-	EndDate     *time.Time `json:"end_date,omitempty" db:"end_date"`
-	Description string    `json:"description" db:"description" validate:"required,min=10,max=2000"`
-	Location    string    `json:"location" db:"location" validate:"required,min=2,max=100"`
-	IsCurrent   bool      `json:"is_current" db:"is_current"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	XMLName     xml.Name   `json:"-" xml:"experience"`
+	ID          int        `json:"id" xml:"id" db:"id"`
+	Company     string     `json:"company" xml:"company" db:"company" validate:"required,min=2,max=100"`
+	Position    string     `json:"position" xml:"position" db:"position" validate:"required,min=2,max=100"`
+	StartDate   time.Time  `json:"start_date" xml:"start_date" db:"start_date" validate:"required"`
+	EndDate     *time.Time `json:"end_date,omitempty" xml:"end_date,omitempty" db:"end_date"`
+	Description string     `json:"description" xml:"description" db:"description" validate:"required,min=10,max=2000"`
+	Location    string     `json:"location" xml:"location" db:"location" validate:"required,min=2,max=100"`
+	IsCurrent   bool       `json:"is_current" xml:"is_current" db:"is_current"`
+	CreatedAt   time.Time  `json:"created_at" xml:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" xml:"updated_at" db:"updated_at"`
+	Publication
 }
 
 // Skill represents a technical skill
 type Skill struct {
-	ID          int       `json:"id" db:"id"`
-	Name        string    `json:"name" db:"name" validate:"required,min=1,max=100"`
-	Category    string    `json:"category" db:"category" validate:"required"`
-	Level       string    `json:"level" db:"level" validate:"required,oneof=Beginner Intermediate Advanced Expert"`
-	YearsOfExp  *int      `json:"years_of_experience,omitempty" db:"years_of_experience" validate:"omitempty,min=0,max=50"`
-	Description *string   `json:"description,omitempty" db:"description" validate:"omitempty,max=500"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	XMLName     xml.Name  `json:"-" xml:"skill"`
+	ID          int       `json:"id" xml:"id" db:"id"`
+	Name        string    `json:"name" xml:"name" db:"name" validate:"required,min=1,max=100"`
+	Category    string    `json:"category" xml:"category" db:"category" validate:"required"`
+	Level       string    `json:"level" xml:"level" db:"level" validate:"required,oneof=Beginner Intermediate Advanced Expert"`
+	YearsOfExp  *int      `json:"years_of_experience,omitempty" xml:"years_of_experience,omitempty" db:"years_of_experience" validate:"omitempty,min=0,max=50"`
+	Description *string   `json:"description,omitempty" xml:"description,omitempty" db:"description" validate:"omitempty,max=500"`
+	CreatedAt   time.Time `json:"created_at" xml:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" xml:"updated_at" db:"updated_at"`
+	Publication
 }
 
 // SkillCategory represents skill categories for grouping
 type SkillCategory struct {
-	Category string  `json:"category"`
-	Skills   []Skill `json:"skills"`
+	XMLName  xml.Name `json:"-" xml:"skill_category"`
+	Category string   `json:"category" xml:"category"`
+	Skills   []Skill  `json:"skills" xml:"skills>skill"`
 }
 
 // Education represents educational background
 type Education struct {
-	ID          int       `json:"id" db:"id"`
-	Institution string    `json:"institution" db:"institution" validate:"required,min=2,max=200"`
-	Degree      string    `json:"degree" db:"degree" validate:"required,min=2,max=100"`
-	Field       string    `json:"field" db:"field" validate:"required,min=2,max=100"`
-	StartDate   time.Time `json:"start_date" db:"start_date" validate:"required"`
-	EndDate     *time.Time `json:"end_date,omitempty" db:"end_date"`
-	GPA         *float64  `json:"gpa,omitempty" db:"gpa" validate:"omitempty,min=0.0,max=4.0"`
-	Description *string   `json:"description,omitempty" db:"description" validate:"omitempty,max=1000"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	XMLName     xml.Name   `json:"-" xml:"education"`
+	ID          int        `json:"id" xml:"id" db:"id"`
+	Institution string     `json:"institution" xml:"institution" db:"institution" validate:"required,min=2,max=200"`
+	Degree      string     `json:"degree" xml:"degree" db:"degree" validate:"required,min=2,max=100"`
+	Field       string     `json:"field" xml:"field" db:"field" validate:"required,min=2,max=100"`
+	StartDate   time.Time  `json:"start_date" xml:"start_date" db:"start_date" validate:"required"`
+	EndDate     *time.Time `json:"end_date,omitempty" xml:"end_date,omitempty" db:"end_date"`
+	GPA         *float64   `json:"gpa,omitempty" xml:"gpa,omitempty" db:"gpa" validate:"omitempty,min=0.0,max=4.0"`
+	Description *string    `json:"description,omitempty" xml:"description,omitempty" db:"description" validate:"omitempty,max=1000"`
+	CreatedAt   time.Time  `json:"created_at" xml:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" xml:"updated_at" db:"updated_at"`
+	Publication
 }
 
 // Certification represents professional certifications
 type Certification struct {
-	ID           int        `json:"id" db:"id"`
-	Name         string     `json:"name" db:"name" validate:"required,min=2,max=200"`
-	Issuer       string     `json:"issuer" db:"issuer" validate:"required,min=2,max=200"`
-	IssueDate    time.Time  `json:"issue_date" db:"issue_date" validate:"required"`
-	ExpiryDate   *time.Time `json:"expiry_date,omitempty" db:"expiry_date"`
-	CredentialID *string    `json:"credential_id,omitempty" db:"credential_id" validate:"omitempty,max=100"`
-	URL          *string    `json:"url,omitempty" db:"url" validate:"omitempty,url"`
-	Description  *string    `json:"description,omitempty" db:"description" validate:"omitempty,max=1000"`
-	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+	XMLName      xml.Name   `json:"-" xml:"certification"`
+	ID           int        `json:"id" xml:"id" db:"id"`
+	Name         string     `json:"name" xml:"name" db:"name" validate:"required,min=2,max=200"`
+	Issuer       string     `json:"issuer" xml:"issuer" db:"issuer" validate:"required,min=2,max=200"`
+	IssueDate    time.Time  `json:"issue_date" xml:"issue_date" db:"issue_date" validate:"required"`
+	ExpiryDate   *time.Time `json:"expiry_date,omitempty" xml:"expiry_date,omitempty" db:"expiry_date"`
+	CredentialID *string    `json:"credential_id,omitempty" xml:"credential_id,omitempty" db:"credential_id" validate:"omitempty,max=100"`
+	URL          *string    `json:"url,omitempty" xml:"url,omitempty" db:"url" validate:"omitempty,url"`
+	Description  *string    `json:"description,omitempty" xml:"description,omitempty" db:"description" validate:"omitempty,max=1000"`
+	CreatedAt    time.Time  `json:"created_at" xml:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" xml:"updated_at" db:"updated_at"`
+	Publication
 }
 
+// Publication states
+const (
+	PublicationDraft     = "draft"
+	PublicationPublished = "published"
+	PublicationArchived  = "archived"
+)
+
+// Publication holds the publication state of a portfolio entry. The scheduler
+// publishes drafts once PublishAt passes and archives published entries once
+// UnpublishAt passes.
+type Publication struct {
+	PublicationStatus string     `json:"publication_status" xml:"publication_status" db:"publication_status"`
+	PublishAt         *time.Time `json:"publish_at,omitempty" xml:"publish_at,omitempty" db:"publish_at"`
+	UnpublishAt       *time.Time `json:"unpublish_at,omitempty" xml:"unpublish_at,omitempty" db:"unpublish_at"`
+}
+
+// UpdatePublicationRequest represents the request payload for changing the publication of an entry
+type UpdatePublicationRequest struct {
+	PublicationStatus string     `json:"publication_status" validate:"required,oneof=draft published archived"`
+	PublishAt         *time.Time `json:"publish_at,omitempty"`
+	UnpublishAt       *time.Time `json:"unpublish_at,omitempty"`
+}
+
+// Scope selects which portfolio entries a query returns
+type Scope string
+
+const (
+	ScopePublished Scope = "published" // entries currently visible to visitors
+	ScopeAll       Scope = "all"       // every entry, for admin routes
+)
+
 // APIResponse represents the standard API response format
 type APIResponse struct {
-	Data    interface{} `json:"data"`
-	Success bool        `json:"success"`
-	Message *string     `json:"message,omitempty"`
+	Data       interface{} `json:"data"`
+	Success    bool        `json:"success"`
+	Message    *string     `json:"message,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// Pagination describes the page returned by paginated list endpoints
+type Pagination struct {
+	XMLName    xml.Name `json:"-" xml:"pagination"`
+	Page       int      `json:"page" xml:"page"`
+	PerPage    int      `json:"per_page" xml:"per_page"`
+	Total      int      `json:"total" xml:"total"`
+	TotalPages int      `json:"total_pages" xml:"total_pages"`
+}
+
+// NewPagination builds the pagination metadata for a page of total items
+func NewPagination(page, perPage, total int) *Pagination {
+	totalPages := 0
+	if perPage > 0 {
+		totalPages = (total + perPage - 1) / perPage
+	}
+	return &Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
+
+// ListOptions holds the page requested from a paginated list endpoint.
+// A zero PerPage returns every matching row.
+type ListOptions struct {
+	Page    int
+	PerPage int
+}
+
+// Offset returns the number of rows skipped before the requested page
+func (o ListOptions) Offset() int {
+	if o.Page < 1 {
+		return 0
+	}
+	return (o.Page - 1) * o.PerPage
 }
 
 // APIError represents API error responses
 type APIError struct {
-	Error   string                 `json:"error"`
-	Message string                 `json:"message"`
-	Details map[string]interface{} `json:"details,omitempty"`
+	Error         string                 `json:"error"`
+	Message       string                 `json:"message"`
+	Details       map[string]interface{} `json:"details,omitempty"`
+	CorrelationID string                 `json:"correlation_id,omitempty"`
 }
 
 // Project represents a portfolio project
 type Project struct {
-	ID               int        `json:"id" db:"id"`
-	Title            string     `json:"title" db:"title" validate:"required,min=2,max=200"`
-	Description      string     `json:"description" db:"description" validate:"required,min=10,max=2000"`
-	ShortDescription *string    `json:"short_description,omitempty" db:"short_description" validate:"omitempty,max=500"`
-	Technologies     []string   `json:"technologies" db:"technologies" validate:"required,min=1"`
-	GitHubURL        *string    `json:"github_url,omitempty" db:"github_url" validate:"omitempty,url"`
-	LiveURL          *string    `json:"live_url,omitempty" db:"live_url" validate:"omitempty,url"`
-	ImageURL         *string    `json:"image_url,omitempty" db:"image_url" validate:"omitempty,url"`
-	StartDate        time.Time  `json:"start_date" db:"start_date" validate:"required"`
-	EndDate          *time.Time `json:"end_date,omitempty" db:"end_date"`
-	Status           string     `json:"status" db:"status" validate:"required,oneof=Planning 'In Progress' Completed 'On Hold' Cancelled"`
-	Featured         bool       `json:"featured" db:"featured"`
-	SortOrder        int        `json:"sort_order" db:"sort_order"`
-	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+	XMLName          xml.Name       `json:"-" xml:"project"`
+	ID               int            `json:"id" xml:"id" db:"id"`
+	Title            string         `json:"title" xml:"title" db:"title" validate:"required,min=2,max=200"`
+	Description      string         `json:"description" xml:"description" db:"description" validate:"required,min=10,max=2000"`
+	ShortDescription *string        `json:"short_description,omitempty" xml:"short_description,omitempty" db:"short_description" validate:"omitempty,max=500"`
+	Technologies     []string       `json:"technologies" xml:"technologies>technology" db:"technologies" validate:"required,min=1"`
+	GitHubURL        *string        `json:"github_url,omitempty" xml:"github_url,omitempty" db:"github_url" validate:"omitempty,url"`
+	LiveURL          *string        `json:"live_url,omitempty" xml:"live_url,omitempty" db:"live_url" validate:"omitempty,url"`
+	ImageURL         *string        `json:"image_url,omitempty" xml:"image_url,omitempty" db:"image_url" validate:"omitempty,url"`
+	StartDate        time.Time      `json:"start_date" xml:"start_date" db:"start_date" validate:"required"`
+	EndDate          *time.Time     `json:"end_date,omitempty" xml:"end_date,omitempty" db:"end_date"`
+	Status           string         `json:"status" xml:"status" db:"status" validate:"required,oneof=Planning 'In Progress' Completed 'On Hold' Cancelled"`
+	Featured         bool           `json:"featured" xml:"featured" db:"featured"`
+	SortOrder        int            `json:"sort_order" xml:"sort_order" db:"sort_order"`
+	CreatedAt        time.Time      `json:"created_at" xml:"created_at" db:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at" xml:"updated_at" db:"updated_at"`
+	Media            []ProjectMedia `json:"media,omitempty" xml:"media>media_item,omitempty"`
+	Publication
+}
+
+// UpdateProjectRequest represents the request payload for updating a project
+type UpdateProjectRequest struct {
+	Title            string     `json:"title" validate:"required,min=2,max=200"`
+	Description      string     `json:"description" validate:"required,min=10,max=2000"`
+	ShortDescription *string    `json:"short_description,omitempty" validate:"omitempty,max=500"`
+	Technologies     []string   `json:"technologies" validate:"required,min=1"`
+	GitHubURL        *string    `json:"github_url,omitempty" validate:"omitempty,url"`
+	LiveURL          *string    `json:"live_url,omitempty" validate:"omitempty,url"`
+	ImageURL         *string    `json:"image_url,omitempty" validate:"omitempty,uri"`
+	StartDate        time.Time  `json:"start_date" validate:"required"`
+	EndDate          *time.Time `json:"end_date,omitempty"`
+	Status           string     `json:"status" validate:"required,oneof=Planning 'In Progress' Completed 'On Hold' Cancelled"`
+	Featured         bool       `json:"featured"`
+	SortOrder        int        `json:"sort_order"`
+}
+
+// Project media types
+const (
+	MediaTypeImage = "image"
+	MediaTypeVideo = "video"
+)
+
+// ProjectMedia is one image or video embed in a project's gallery
+type ProjectMedia struct {
+	XMLName   xml.Name  `json:"-" xml:"media_item"`
+	ID        int       `json:"id" xml:"id" db:"id"`
+	ProjectID int       `json:"project_id" xml:"project_id" db:"project_id"`
+	Type      string    `json:"type" xml:"type" db:"media_type"`
+	URL       string    `json:"url" xml:"url" db:"url"`
+	Caption   *string   `json:"caption,omitempty" xml:"caption,omitempty" db:"caption"`
+	AltText   string    `json:"alt_text" xml:"alt_text" db:"alt_text"`
+	Width     *int      `json:"width,omitempty" xml:"width,omitempty" db:"width"`
+	Height    *int      `json:"height,omitempty" xml:"height,omitempty" db:"height"`
+	Position  int       `json:"position" xml:"position" db:"position"`
+	CreatedAt time.Time `json:"created_at" xml:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" xml:"updated_at" db:"updated_at"`
+}
+
+// AddProjectMediaRequest represents the request payload for adding a gallery
+// item. Images may use relative /media URLs; videos need an embed URL.
+type AddProjectMediaRequest struct {
+	Type    string  `json:"type" validate:"required,oneof=image video"`
+	URL     string  `json:"url" validate:"required,uri,max=500"`
+	Caption *string `json:"caption,omitempty" validate:"omitempty,max=500"`
+	AltText string  `json:"alt_text" validate:"required,max=300"`
+	Width   *int    `json:"width,omitempty" validate:"omitempty,min=1,max=20000"`
+	Height  *int    `json:"height,omitempty" validate:"omitempty,min=1,max=20000"`
+}
+
+// ReorderProjectMediaRequest lists every gallery item ID of a project in the
+// new order
+type ReorderProjectMediaRequest struct {
+	IDs []int `json:"ids" validate:"required,min=1,dive,min=1"`
+}
+
+// ImageVariant is one resized rendition of an uploaded image
+type ImageVariant struct {
+	XMLName xml.Name `json:"-" xml:"variant"`
+	Width   int      `json:"width" xml:"width"`
+	Height  int      `json:"height" xml:"height"`
+	Format  string   `json:"format" xml:"format"`
+	URL     string   `json:"url" xml:"url"`
+}
+
+// ProjectImage describes an uploaded project image. ImageURL, the largest
+// JPEG variant, is also stored as the project's image_url.
+type ProjectImage struct {
+	XMLName  xml.Name       `json:"-" xml:"project_image"`
+	ImageURL string         `json:"image_url" xml:"image_url"`
+	Variants []ImageVariant `json:"variants" xml:"variants>variant"`
 }
 
 // HealthResponse represents health check response
 type HealthResponse struct {
-	Status     string            `json:"status"`
-	Timestamp  time.Time         `json:"timestamp"`
-	Version    string            `json:"version"`
-	Components map[string]string `json:"components"`
-}
\ No newline at end of file
+	XMLName    xml.Name  `json:"-" xml:"health"`
+	Status     string    `json:"status" xml:"status"`
+	Timestamp  time.Time `json:"timestamp" xml:"timestamp"`
+	Version    string    `json:"version" xml:"version"`
+	Commit     string    `json:"commit" xml:"commit"`
+	Components StringMap `json:"components" xml:"components"`
+}
+
+// ProblemDetails represents an RFC 9457 problem details response
+type ProblemDetails struct {
+	Type     string                 `json:"type"`
+	Title    string                 `json:"title"`
+	Status   int                    `json:"status"`
+	Detail   string                 `json:"detail,omitempty"`
+	Instance string                 `json:"instance,omitempty"`
+	Code     string                 `json:"code"`
+	Errors   map[string]interface{} `json:"errors,omitempty"`
+}
+
+// StringMap is a string map that can also be encoded as XML
+type StringMap map[string]string
+
+// MarshalXML encodes the map as <entry key="...">value</entry> elements in key order
+func (m StringMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		entry := xml.StartElement{
+			Name: xml.Name{Local: "entry"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: key}},
+		}
+		if err := e.EncodeElement(m[key], entry); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// Tenant represents the owner of a hosted portfolio
+type Tenant struct {
+	ID           int       `json:"id" db:"id"`
+	Slug         string    `json:"slug" db:"slug"`
+	Name         string    `json:"name" db:"name"`
+	CustomDomain *string   `json:"custom_domain,omitempty" db:"custom_domain"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Entity types used to reference portfolio rows from shared tables
+const (
+	EntityProfile       = "profile"
+	EntityExperience    = "experience"
+	EntitySkill         = "skill"
+	EntityEducation     = "education"
+	EntityCertification = "certification"
+	EntityProject       = "project"
+	EntityProjectMedia  = "project_media"
+	EntityMessage       = "message"
+)
+
+// Translation represents a localized value of a free-text field
+type Translation struct {
+	EntityType string `json:"entity_type" db:"entity_type"`
+	EntityID   int    `json:"entity_id" db:"entity_id"`
+	Field      string `json:"field" db:"field"`
+	Locale     string `json:"locale" db:"locale"`
+	Value      string `json:"value" db:"value"`
+}
+
+// ContactRequest represents the request payload for POST /v1/contact
+type ContactRequest struct {
+	Name      string `json:"name" validate:"required,min=2,max=100"`
+	Email     string `json:"email" validate:"required,email,max=254"`
+	Subject   string `json:"subject" validate:"required,min=2,max=200"`
+	Message   string `json:"message" validate:"required,min=10,max=5000"`
+	FormToken string `json:"form_token" validate:"required"`
+	Website   string `json:"website"` // Honeypot, must stay empty
+}
+
+// ContactFormToken is issued before the contact form is rendered
+type ContactFormToken struct {
+	XMLName   xml.Name  `json:"-" xml:"contact_form_token"`
+	Token     string    `json:"token" xml:"token"`
+	ExpiresAt time.Time `json:"expires_at" xml:"expires_at"`
+}
+
+// Message statuses
+const (
+	MessageStatusUnread   = "unread"
+	MessageStatusRead     = "read"
+	MessageStatusArchived = "archived"
+)
+
+// Message represents a message submitted through the contact form
+type Message struct {
+	XMLName    xml.Name  `json:"-" xml:"message"`
+	ID         int       `json:"id" xml:"id" db:"id"`
+	Name       string    `json:"name" xml:"name" db:"name"`
+	Email      string    `json:"email" xml:"email" db:"email"`
+	Subject    string    `json:"subject" xml:"subject" db:"subject"`
+	Body       string    `json:"body" xml:"body" db:"body"`
+	IPAddress  string    `json:"ip_address" xml:"ip_address" db:"ip_address"`
+	UserAgent  string    `json:"user_agent" xml:"user_agent" db:"user_agent"`
+	Status     string    `json:"status" xml:"status" db:"status"`
+	IsSpam     bool      `json:"is_spam" xml:"is_spam" db:"is_spam"`
+	SpamReason *string   `json:"spam_reason,omitempty" xml:"spam_reason,omitempty" db:"spam_reason"`
+	CreatedAt  time.Time `json:"created_at" xml:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" xml:"updated_at" db:"updated_at"`
+}
+
+// MessageFilter selects messages in the admin inbox
+type MessageFilter struct {
+	Status string // unread, read or archived; empty matches all
+	Spam   *bool  // nil matches spam and non-spam
+	Search string // matched against name, email, subject and body
+	ListOptions
+}
+
+// UpdateMessageRequest represents the request payload for marking a message
+type UpdateMessageRequest struct {
+	Status *string `json:"status,omitempty" validate:"omitempty,oneof=unread read archived"`
+	IsSpam *bool   `json:"is_spam,omitempty"`
+}
+
+// BulkUpdateMessagesRequest represents the request payload for marking several messages
+type BulkUpdateMessagesRequest struct {
+	IDs    []int   `json:"ids" validate:"required,min=1,max=500,dive,min=1"`
+	Status *string `json:"status,omitempty" validate:"omitempty,oneof=unread read archived"`
+	IsSpam *bool   `json:"is_spam,omitempty"`
+}
+
+// BulkDeleteMessagesRequest represents the request payload for deleting several messages
+type BulkDeleteMessagesRequest struct {
+	IDs []int `json:"ids" validate:"required,min=1,max=500,dive,min=1"`
+}
+
+// BulkResult reports how many rows a bulk operation affected
+type BulkResult struct {
+	XMLName  xml.Name `json:"-" xml:"result"`
+	Affected int64    `json:"affected" xml:"affected"`
+}
+
+// Outbox entry statuses
+const (
+	OutboxStatusPending = "pending"
+	OutboxStatusSent    = "sent"
+	OutboxStatusFailed  = "failed"
+)
+
+// OutboxEntry represents an email waiting to be delivered by the outbox worker
+type OutboxEntry struct {
+	ID            int       `json:"id" db:"id"`
+	TenantID      int       `json:"tenant_id" db:"tenant_id"`
+	MessageID     *int      `json:"message_id,omitempty" db:"message_id"`
+	Recipient     string    `json:"recipient" db:"recipient"`
+	ReplyTo       *string   `json:"reply_to,omitempty" db:"reply_to"`
+	Subject       string    `json:"subject" db:"subject"`
+	Body          string    `json:"body" db:"body"`
+	CorrelationID *string   `json:"correlation_id,omitempty" db:"correlation_id"`
+	Status        string    `json:"status" db:"status"`
+	Attempts      int       `json:"attempts" db:"attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at" db:"next_attempt_at"`
+}
+
+// Audit actions
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+	AuditActionPurge   = "purge"
+)
+
+// Actors recorded when a change is not made with an admin token
+const (
+	ActorAnonymous = "anonymous"
+	ActorSystem    = "system"
+)
+
+// FieldChange is one changed field of an audited entity
+type FieldChange struct {
+	XMLName xml.Name    `json:"-" xml:"change"`
+	Field   string      `json:"field" xml:"field"`
+	Before  interface{} `json:"before" xml:"before,omitempty"`
+	After   interface{} `json:"after" xml:"after,omitempty"`
+}
+
+// AuditEvent is a durable record of one change to an entity
+type AuditEvent struct {
+	XMLName       xml.Name      `json:"-" xml:"audit_event"`
+	ID            int64         `json:"id" xml:"id" db:"id"`
+	Actor         string        `json:"actor" xml:"actor" db:"actor"`
+	CorrelationID *string       `json:"correlation_id,omitempty" xml:"correlation_id,omitempty" db:"correlation_id"`
+	EntityType    string        `json:"entity_type" xml:"entity_type" db:"entity_type"`
+	EntityID      int           `json:"entity_id" xml:"entity_id" db:"entity_id"`
+	Action        string        `json:"action" xml:"action" db:"action"`
+	Changes       []FieldChange `json:"changes" xml:"changes>change" db:"changes"`
+	CreatedAt     time.Time     `json:"created_at" xml:"created_at" db:"created_at"`
+}
+
+// AuditFilter selects audit events in the admin audit log
+type AuditFilter struct {
+	EntityType string
+	EntityID   *int
+	Actor      string
+	From       *time.Time
+	To         *time.Time
+	ListOptions
+}
+
+// Revision is a full snapshot of a profile or project taken after a change
+type Revision struct {
+	XMLName       xml.Name    `json:"-" xml:"revision"`
+	ID            int64       `json:"id" xml:"id" db:"id"`
+	EntityType    string      `json:"entity_type" xml:"entity_type" db:"entity_type"`
+	EntityID      int         `json:"entity_id" xml:"entity_id" db:"entity_id"`
+	Revision      int         `json:"revision" xml:"number" db:"revision"`
+	Actor         string      `json:"actor" xml:"actor" db:"actor"`
+	CorrelationID *string     `json:"correlation_id,omitempty" xml:"correlation_id,omitempty" db:"correlation_id"`
+	Snapshot      interface{} `json:"snapshot,omitempty" xml:"snapshot,omitempty" db:"snapshot"` // *Profile or *Project
+	CreatedAt     time.Time   `json:"created_at" xml:"created_at" db:"created_at"`
+}
+
+// TrashItem is a soft-deleted entry awaiting restore or purge
+type TrashItem struct {
+	XMLName    xml.Name  `json:"-" xml:"trash_item"`
+	EntityType string    `json:"entity_type" xml:"entity_type" db:"entity_type"`
+	EntityID   int       `json:"entity_id" xml:"entity_id" db:"entity_id"`
+	Label      string    `json:"label" xml:"label" db:"label"`
+	DeletedAt  time.Time `json:"deleted_at" xml:"deleted_at" db:"deleted_at"`
+}
+
+// TrashFilter selects items in the admin trash
+type TrashFilter struct {
+	EntityType string
+	ListOptions
+}
+
+// RevisionDiff lists the fields that differ between two revisions
+type RevisionDiff struct {
+	XMLName xml.Name      `json:"-" xml:"revision_diff"`
+	From    int           `json:"from" xml:"from"`
+	To      int           `json:"to" xml:"to"`
+	Changes []FieldChange `json:"changes" xml:"changes>change"`
+}
@@ -0,0 +1,78 @@
+package ogimage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cache keeps rendered cards on disk. Each card has a name, such as
+// "3/project-12-en", and a version derived from the updated_at and the
+// localized text of the content it shows; storing a new version removes the
+// older ones.
+type Cache struct {
+	dir string
+}
+
+// NewCache creates a cache in dir. Directories are created on first write.
+func NewCache(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// Get returns a cached card, or false if this version is not cached
+func (c *Cache) Get(name, version string) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(name, version))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Put stores a version of a card and removes its other versions
+func (c *Cache) Put(name, version string, data []byte) error {
+	path := c.path(name, version)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create OG image cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create OG image cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write OG image cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write OG image cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store OG image cache file: %w", err)
+	}
+
+	stale, err := filepath.Glob(c.path(name, "*"))
+	if err != nil {
+		return nil
+	}
+	for _, other := range stale {
+		if other != path {
+			if err := os.Remove(other); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("failed to remove stale OG image: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// path returns the file of a card version. Names and versions are built by
+// the caller from IDs, locales, timestamps and hashes, so they only need separators
+// neutralized.
+func (c *Cache) path(name, version string) string {
+	clean := strings.NewReplacer("..", "_", "\\", "_")
+	return filepath.Join(c.dir, filepath.FromSlash(clean.Replace(name))+"@"+clean.Replace(version)+".png")
+}
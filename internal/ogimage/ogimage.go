@@ -0,0 +1,285 @@
+// Package ogimage renders the 1200x630 PNG share cards that social networks
+// show in link previews. Cards are drawn in pure Go with the embedded Go
+// fonts, so rendering needs neither a browser nor system fonts.
+package ogimage
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Card dimensions recommended by the OpenGraph consumers
+const (
+	Width  = 1200
+	Height = 630
+)
+
+// Layout
+const (
+	padding     = 80
+	accentWidth = 16
+	chipHeight  = 48
+	chipPadding = 20
+	chipGap     = 14
+	chipRadius  = 12
+	maxChips    = 8
+)
+
+var (
+	backgroundColor  = color.RGBA{0x0f, 0x17, 0x2a, 0xff}
+	accentColor      = color.RGBA{0x38, 0xbd, 0xf8, 0xff}
+	titleColor       = color.RGBA{0xf8, 0xfa, 0xfc, 0xff}
+	descriptionColor = color.RGBA{0xcb, 0xd5, 0xe1, 0xff}
+	chipColor        = color.RGBA{0x1e, 0x29, 0x3b, 0xff}
+	chipTextColor    = color.RGBA{0xe2, 0xe8, 0xf0, 0xff}
+	footerColor      = color.RGBA{0x94, 0xa3, 0xb8, 0xff}
+)
+
+// Card is the content of a share card
+type Card struct {
+	Title       string
+	Description string
+	Chips       []string // e.g. technologies, drawn until the row is full
+	Footer      string   // e.g. the portfolio owner's name
+}
+
+// Renderer draws share cards. It is safe for concurrent use.
+type Renderer struct {
+	once    sync.Once
+	regular *opentype.Font
+	bold    *opentype.Font
+	err     error
+}
+
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+// loadFonts parses the embedded fonts on first use
+func (r *Renderer) loadFonts() error {
+	r.once.Do(func() {
+		if r.regular, r.err = opentype.Parse(goregular.TTF); r.err != nil {
+			r.err = fmt.Errorf("failed to parse regular font: %w", r.err)
+			return
+		}
+		if r.bold, r.err = opentype.Parse(gobold.TTF); r.err != nil {
+			r.err = fmt.Errorf("failed to parse bold font: %w", r.err)
+		}
+	})
+	return r.err
+}
+
+// faces holds the font faces of one render. Faces keep glyph buffers and
+// must not be shared between goroutines.
+type faces struct {
+	title, description, chip, footer font.Face
+}
+
+func (r *Renderer) newFaces() (*faces, error) {
+	if err := r.loadFonts(); err != nil {
+		return nil, err
+	}
+
+	newFace := func(f *opentype.Font, size float64) (font.Face, error) {
+		return opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	}
+
+	var fs faces
+	var err error
+	if fs.title, err = newFace(r.bold, 64); err != nil {
+		return nil, err
+	}
+	if fs.description, err = newFace(r.regular, 32); err != nil {
+		return nil, err
+	}
+	if fs.chip, err = newFace(r.regular, 24); err != nil {
+		return nil, err
+	}
+	if fs.footer, err = newFace(r.bold, 28); err != nil {
+		return nil, err
+	}
+	return &fs, nil
+}
+
+// Render draws card and encodes it as a PNG
+func (r *Renderer) Render(card Card) ([]byte, error) {
+	fs, err := r.newFaces()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create font faces: %w", err)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(backgroundColor), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, accentWidth, Height), image.NewUniform(accentColor), image.Point{}, draw.Src)
+
+	textWidth := Width - 2*padding
+	y := padding
+
+	// Title, up to two lines
+	y = drawLines(img, fs.title, titleColor, wrap(fs.title, card.Title, textWidth, 2), y, 76)
+
+	// Description, up to three lines
+	if card.Description != "" {
+		y = drawLines(img, fs.description, descriptionColor, wrap(fs.description, card.Description, textWidth, 3), y+24, 44)
+	}
+
+	// Chips on one row above the footer
+	if len(card.Chips) > 0 {
+		drawChips(img, fs.chip, card.Chips, Height-padding-60-chipHeight, textWidth)
+	}
+
+	// Footer
+	if card.Footer != "" {
+		footer := wrap(fs.footer, card.Footer, textWidth, 1)
+		drawLines(img, fs.footer, footerColor, footer, Height-padding-36, 36)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode PNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawLines draws lines starting with the top of the first line at top and
+// returns the y coordinate below the last line
+func drawLines(dst draw.Image, face font.Face, c color.Color, lines []string, top int, lineHeight int) int {
+	ascent := face.Metrics().Ascent.Ceil()
+	d := &font.Drawer{Dst: dst, Src: image.NewUniform(c), Face: face}
+	for i, line := range lines {
+		d.Dot = fixed.P(padding, top+i*lineHeight+ascent)
+		d.DrawString(line)
+	}
+	return top + len(lines)*lineHeight
+}
+
+// drawChips draws labels as rounded chips from the left, stopping before the
+// first chip that would overflow maxWidth
+func drawChips(dst draw.Image, face font.Face, labels []string, top int, maxWidth int) {
+	metrics := face.Metrics()
+	baseline := top + (chipHeight+metrics.Ascent.Ceil()-metrics.Descent.Ceil())/2
+	d := &font.Drawer{Dst: dst, Src: image.NewUniform(chipTextColor), Face: face}
+
+	x := padding
+	for i, label := range labels {
+		if i == maxChips {
+			break
+		}
+		label = strings.TrimSpace(label)
+		if label == "" {
+			continue
+		}
+
+		width := font.MeasureString(face, label).Ceil() + 2*chipPadding
+		if x+width > padding+maxWidth {
+			break
+		}
+
+		chip := image.Rect(x, top, x+width, top+chipHeight)
+		draw.DrawMask(dst, chip, image.NewUniform(chipColor), image.Point{}, &roundedRect{rect: chip, radius: chipRadius}, chip.Min, draw.Over)
+
+		d.Dot = fixed.P(x+chipPadding, baseline)
+		d.DrawString(label)
+
+		x += width + chipGap
+	}
+}
+
+// wrap breaks text into at most maxLines lines no wider than maxWidth,
+// ending the last line with an ellipsis when text does not fit
+func wrap(face font.Face, text string, maxWidth int, maxLines int) []string {
+	words := strings.Fields(text)
+	fits := func(s string) bool {
+		return font.MeasureString(face, s).Ceil() <= maxWidth
+	}
+
+	var lines []string
+	var line string
+	for i, word := range words {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if fits(candidate) {
+			line = candidate
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, truncate(face, line, maxWidth))
+		}
+		line = word
+		if len(lines) == maxLines-1 {
+			// Last line: take everything that is left and truncate it
+			line = strings.Join(words[i:], " ")
+			break
+		}
+	}
+
+	if line != "" {
+		lines = append(lines, truncate(face, line, maxWidth))
+	}
+	return lines
+}
+
+// truncate shortens s with an ellipsis until it fits maxWidth
+func truncate(face font.Face, s string, maxWidth int) string {
+	if font.MeasureString(face, s).Ceil() <= maxWidth {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimRight(string(runes), " ") + "…"
+		if font.MeasureString(face, candidate).Ceil() <= maxWidth {
+			return candidate
+		}
+	}
+	return "…"
+}
+
+// roundedRect is an alpha mask of a rectangle with rounded corners
+type roundedRect struct {
+	rect   image.Rectangle
+	radius int
+}
+
+func (r *roundedRect) ColorModel() color.Model { return color.AlphaModel }
+
+func (r *roundedRect) Bounds() image.Rectangle { return r.rect }
+
+func (r *roundedRect) At(x, y int) color.Color {
+	if !(image.Point{X: x, Y: y}).In(r.rect) {
+		return color.Transparent
+	}
+
+	// Distance into the corner square, if (x, y) lies in one
+	cx, cy := 0, 0
+	if x < r.rect.Min.X+r.radius {
+		cx = r.rect.Min.X + r.radius - x
+	} else if x >= r.rect.Max.X-r.radius {
+		cx = x - (r.rect.Max.X - r.radius - 1)
+	}
+	if y < r.rect.Min.Y+r.radius {
+		cy = r.rect.Min.Y + r.radius - y
+	} else if y >= r.rect.Max.Y-r.radius {
+		cy = y - (r.rect.Max.Y - r.radius - 1)
+	}
+
+	if cx*cx+cy*cy > r.radius*r.radius {
+		return color.Transparent
+	}
+	return color.Opaque
+}
@@ -0,0 +1,68 @@
+package publishing
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/database/repositories"
+)
+
+// Scheduler periodically publishes drafts whose publish_at has passed and
+// archives entries whose unpublish_at has passed
+type Scheduler struct {
+	repo   repositories.PublicationRepository
+	config config.PublishingConfig
+}
+
+// NewScheduler creates a new publication scheduler
+func NewScheduler(repo repositories.PublicationRepository, cfg config.PublishingConfig) *Scheduler {
+	return &Scheduler{
+		repo:   repo,
+		config: cfg,
+	}
+}
+
+// Run applies publication schedules until ctx is cancelled. A non-positive
+// interval disables the scheduler.
+func (s *Scheduler) Run(ctx context.Context) {
+	if s.config.Interval <= 0 {
+		log.Info().Msg("Publication scheduler disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	log.Info().
+		Dur("interval", s.config.Interval).
+		Msg("Publication scheduler started")
+
+	for {
+		s.apply(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Publication scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// apply runs one pass over the due publication changes
+func (s *Scheduler) apply(ctx context.Context) {
+	// Tenants changed before a failure are still returned and logged
+	tenantIDs, err := s.repo.ApplySchedules(ctx)
+	if err != nil && ctx.Err() == nil {
+		log.Error().Err(err).Msg("Failed to apply publication schedules")
+	}
+
+	for _, tenantID := range tenantIDs {
+		log.Info().
+			Int("tenant_id", tenantID).
+			Msg("Applied scheduled publication changes")
+	}
+}
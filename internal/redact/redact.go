@@ -0,0 +1,216 @@
+// Package redact masks personal data before it is written to the logs:
+// sensitive query parameters, headers and fields, and client IP addresses.
+// Configure it once at startup; the zero configuration redacts nothing.
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"portfolio-backend/internal/config"
+)
+
+// Placeholder replaces redacted values
+const Placeholder = "[REDACTED]"
+
+// IP modes
+const (
+	IPFull     = "full"
+	IPTruncate = "truncate"
+	IPHash     = "hash"
+)
+
+type rules struct {
+	queryParams map[string]bool
+	headers     map[string]bool
+	fields      map[string]bool
+	ipMode      string
+	ipHashKey   []byte
+}
+
+var active = &rules{ipMode: IPFull}
+
+// Configure installs the redaction rules from the logging configuration.
+// Without LOG_IP_HASH_KEY hashed IPs use a random per-process key, so they
+// can be correlated within one process lifetime only.
+func Configure(cfg config.LoggingConfig) {
+	r := &rules{
+		queryParams: nameSet(cfg.RedactQueryParams),
+		headers:     nameSet(cfg.RedactHeaders),
+		fields:      nameSet(cfg.RedactFields),
+		ipMode:      cfg.IPMode,
+		ipHashKey:   []byte(cfg.IPHashKey),
+	}
+	if r.ipMode == IPHash && len(r.ipHashKey) == 0 {
+		r.ipHashKey = make([]byte, 32)
+		_, _ = rand.Read(r.ipHashKey)
+	}
+	active = r
+}
+
+// nameSet lower-cases names for case-insensitive matching
+func nameSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			set[strings.ToLower(name)] = true
+		}
+	}
+	return set
+}
+
+// Query masks the values of sensitive parameters in a raw query string,
+// leaving the rest of it untouched
+func Query(rawQuery string) string {
+	if rawQuery == "" || len(active.queryParams) == 0 {
+		return rawQuery
+	}
+
+	pairs := strings.Split(rawQuery, "&")
+	for i, pair := range pairs {
+		key, _, hasValue := strings.Cut(pair, "=")
+		if !hasValue {
+			continue
+		}
+		if name, err := url.QueryUnescape(key); err == nil && active.queryParams[strings.ToLower(name)] {
+			pairs[i] = key + "=" + Placeholder
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// Header returns value, or the placeholder for a sensitive header. The
+// addresses in client IP forwarding headers are masked like client IPs.
+func Header(name, value string) string {
+	if value == "" {
+		return value
+	}
+	if active.headers[strings.ToLower(name)] {
+		return Placeholder
+	}
+
+	switch http.CanonicalHeaderKey(name) {
+	case "X-Forwarded-For":
+		return ipList(value)
+	case "X-Real-Ip":
+		return IP(strings.TrimSpace(value))
+	case "Forwarded":
+		return forwarded(value)
+	}
+	return value
+}
+
+// ipList masks every address of a comma-separated list
+func ipList(value string) string {
+	addrs := strings.Split(value, ",")
+	for i, addr := range addrs {
+		addrs[i] = IP(strings.TrimSpace(addr))
+	}
+	return strings.Join(addrs, ", ")
+}
+
+// forwarded masks the for= and by= nodes of an RFC 7239 Forwarded header.
+// Node ports and obfuscated identifiers are masked along with the address.
+func forwarded(value string) string {
+	elements := strings.Split(value, ",")
+	for i, element := range elements {
+		pairs := strings.Split(element, ";")
+		for j, pair := range pairs {
+			key, node, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok || (!strings.EqualFold(key, "for") && !strings.EqualFold(key, "by")) {
+				continue
+			}
+			pairs[j] = key + "=" + IP(forwardedNode(node))
+		}
+		elements[i] = strings.TrimSpace(strings.Join(pairs, ";"))
+	}
+	return strings.Join(elements, ", ")
+}
+
+// forwardedNode strips the quotes, IPv6 brackets and port of a node
+func forwardedNode(node string) string {
+	node = strings.Trim(strings.TrimSpace(node), `"`)
+	if host, _, err := net.SplitHostPort(node); err == nil {
+		return host
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(node, "["), "]")
+}
+
+// Headers flattens request headers for logging, masking sensitive ones
+func Headers(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for name, values := range h {
+		out[name] = Header(name, strings.Join(values, ", "))
+	}
+	return out
+}
+
+// Fields returns a copy of m with the values of sensitive keys masked,
+// descending into nested maps and lists
+func Fields(m map[string]interface{}) map[string]interface{} {
+	if m == nil || len(active.fields) == 0 {
+		return m
+	}
+
+	out := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		if active.fields[strings.ToLower(key)] {
+			out[key] = Placeholder
+			continue
+		}
+		out[key] = fieldValue(value)
+	}
+	return out
+}
+
+func fieldValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return Fields(v)
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = fieldValue(item)
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// IP returns the client IP as the configured mode allows it to be logged
+func IP(ip string) string {
+	switch active.ipMode {
+	case IPTruncate:
+		return truncateIP(ip)
+	case IPHash:
+		mac := hmac.New(sha256.New, active.ipHashKey)
+		mac.Write([]byte(ip))
+		return hex.EncodeToString(mac.Sum(nil))[:16]
+	default:
+		return ip
+	}
+}
+
+// truncateIP zeroes the host part of an address: the last octet of IPv4
+// and everything after the /48 prefix of IPv6. Values that are not a single
+// IP address (such as X-Forwarded-For lists) are masked entirely.
+func truncateIP(ip string) string {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		if ip == "" {
+			return ""
+		}
+		return Placeholder
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return parsed.Mask(net.CIDRMask(48, 128)).String()
+}
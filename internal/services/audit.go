@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+)
+
+type AuditService interface {
+	ListAuditEvents(ctx context.Context, tenantID int, filter models.AuditFilter) ([]models.AuditEvent, *models.Pagination, error)
+}
+
+type auditService struct {
+	auditRepo repositories.AuditRepository
+}
+
+func NewAuditService(auditRepo repositories.AuditRepository) AuditService {
+	return &auditService{
+		auditRepo: auditRepo,
+	}
+}
+
+func (s *auditService) ListAuditEvents(ctx context.Context, tenantID int, filter models.AuditFilter) ([]models.AuditEvent, *models.Pagination, error) {
+	ctx, span := tracer.Start(ctx, "AuditService.ListAuditEvents")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Str("entity_type", filter.EntityType).
+		Str("actor", filter.Actor).
+		Int("page", filter.Page).
+		Msg("Listing audit events")
+
+	events, total, err := s.auditRepo.ListAuditEvents(ctx, tenantID, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to list audit events from repository")
+		return nil, nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	return events, models.NewPagination(filter.Page, filter.PerPage, total), nil
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+)
+
+type CertificationService interface {
+	GetAllCertifications(ctx context.Context, tenantID int, scope models.Scope) ([]models.Certification, error)
+}
+
+type certificationService struct {
+	certificationRepo repositories.CertificationRepository
+	localizer         Localizer
+}
+
+func NewCertificationService(certificationRepo repositories.CertificationRepository, localizer Localizer) CertificationService {
+	return &certificationService{
+		certificationRepo: certificationRepo,
+		localizer:         localizer,
+	}
+}
+
+func (s *certificationService) GetAllCertifications(ctx context.Context, tenantID int, scope models.Scope) ([]models.Certification, error) {
+	ctx, span := tracer.Start(ctx, "CertificationService.GetAllCertifications")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Msg("Getting all certifications")
+
+	certifications, err := s.certificationRepo.GetAllCertifications(ctx, tenantID, scope)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get certifications from repository")
+		return nil, fmt.Errorf("failed to get certifications: %w", err)
+	}
+
+	if err := s.localizer.LocalizeCertifications(ctx, tenantID, certifications); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to localize certifications")
+		return nil, err
+	}
+
+	zerolog.Ctx(ctx).Debug().
+		Int("count", len(certifications)).
+		Msg("Certifications retrieved successfully")
+
+	return certifications, nil
+}
@@ -0,0 +1,255 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/redact"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// Spam reasons recorded on messages that are stored but not forwarded
+const (
+	SpamReasonTooManyLinks = "too_many_links"
+)
+
+// maxUserAgentLength matches the messages.user_agent column
+const maxUserAgentLength = 500
+
+// Form token payloads hold the issue time followed by a random nonce that
+// makes each token single-use
+const (
+	formTokenTimeLength  = 8
+	formTokenNonceLength = 16
+)
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)`)
+
+type ContactService interface {
+	IssueFormToken(ctx context.Context, tenantID int) (*models.ContactFormToken, error)
+	SubmitMessage(ctx context.Context, tenantID int, req models.ContactRequest, ipAddress, userAgent string) error
+}
+
+type contactService struct {
+	messageRepo repositories.MessageRepository
+	profileRepo repositories.ProfileRepository
+	config      config.ContactConfig
+	secret      []byte
+	now         func() time.Time
+}
+
+func NewContactService(messageRepo repositories.MessageRepository, profileRepo repositories.ProfileRepository, cfg config.ContactConfig) ContactService {
+	secret := []byte(cfg.FormSecret)
+	if len(secret) == 0 {
+		// Tokens issued before a restart become invalid, which only costs a form reload
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(fmt.Sprintf("failed to generate contact form secret: %v", err))
+		}
+		log.Warn().Msg("CONTACT_FORM_SECRET is not set, using a random per-process secret")
+	}
+
+	return &contactService{
+		messageRepo: messageRepo,
+		profileRepo: profileRepo,
+		config:      cfg,
+		secret:      secret,
+		now:         time.Now,
+	}
+}
+
+// IssueFormToken returns a signed single-use token recording when the form
+// was rendered
+func (s *contactService) IssueFormToken(ctx context.Context, tenantID int) (*models.ContactFormToken, error) {
+	ctx, span := tracer.Start(ctx, "ContactService.IssueFormToken")
+	defer span.End()
+
+	issuedAt := s.now()
+
+	payload := make([]byte, formTokenTimeLength+formTokenNonceLength)
+	binary.BigEndian.PutUint64(payload, uint64(issuedAt.Unix()))
+	if _, err := rand.Read(payload[formTokenTimeLength:]); err != nil {
+		return nil, fmt.Errorf("failed to generate form token nonce: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(s.sign(tenantID, payload))
+
+	return &models.ContactFormToken{
+		Token:     token,
+		ExpiresAt: issuedAt.Add(s.config.TokenTTL).UTC(),
+	}, nil
+}
+
+// SubmitMessage runs the spam checks, stores the message and queues the
+// notification email for the portfolio owner
+func (s *contactService) SubmitMessage(ctx context.Context, tenantID int, req models.ContactRequest, ipAddress, userAgent string) error {
+	ctx, span := tracer.Start(ctx, "ContactService.SubmitMessage")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Str("ip_address", redact.IP(ipAddress)).
+		Msg("Submitting contact message")
+
+	// Bots fill every field; pretend success so they do not adapt
+	if req.Website != "" {
+		zerolog.Ctx(ctx).Info().
+			Int("tenant_id", tenantID).
+			Str("ip_address", redact.IP(ipAddress)).
+			Msg("Contact message dropped by honeypot")
+		return nil
+	}
+
+	payload, err := s.verifyFormToken(tenantID, req.FormToken)
+	if err != nil {
+		return err
+	}
+
+	// Each token is accepted once, so a fetched token cannot drive a flood
+	issuedAt := time.Unix(int64(binary.BigEndian.Uint64(payload)), 0)
+	nonce := payload[formTokenTimeLength:]
+	if err := s.messageRepo.ConsumeFormToken(ctx, tenantID, nonce, issuedAt.Add(s.config.TokenTTL)); err != nil {
+		if errors.Is(err, apperrors.ErrConflict) {
+			zerolog.Ctx(ctx).Info().
+				Int("tenant_id", tenantID).
+				Str("ip_address", redact.IP(ipAddress)).
+				Msg("Contact message rejected for a reused form token")
+			return invalidFormToken()
+		}
+		return fmt.Errorf("failed to consume form token: %w", err)
+	}
+
+	userAgent = database.TruncateUTF8(userAgent, maxUserAgentLength)
+
+	msg := models.Message{
+		Name:      strings.TrimSpace(req.Name),
+		Email:     strings.TrimSpace(req.Email),
+		Subject:   strings.TrimSpace(req.Subject),
+		Body:      strings.TrimSpace(req.Message),
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+
+	// Link-heavy messages are kept for review but never forwarded
+	if links := len(linkPattern.FindAllString(msg.Subject+" "+msg.Body, -1)); links > s.config.MaxLinks {
+		reason := SpamReasonTooManyLinks
+		msg.IsSpam = true
+		msg.SpamReason = &reason
+
+		if _, err := s.messageRepo.CreateMessage(ctx, tenantID, msg, nil); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to store spam message")
+			return fmt.Errorf("failed to store message: %w", err)
+		}
+
+		zerolog.Ctx(ctx).Info().
+			Int("tenant_id", tenantID).
+			Int("links", links).
+			Msg("Contact message flagged as spam")
+		return nil
+	}
+
+	profile, err := s.profileRepo.GetProfile(ctx, tenantID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get profile for contact recipient")
+		return fmt.Errorf("failed to get contact recipient: %w", err)
+	}
+
+	notification := &models.OutboxEntry{
+		Recipient: profile.Email,
+		ReplyTo:   &msg.Email,
+		Subject:   fmt.Sprintf("[Portfolio] %s", msg.Subject),
+		Body:      notificationBody(msg),
+	}
+
+	created, err := s.messageRepo.CreateMessage(ctx, tenantID, msg, notification)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to store contact message")
+		return fmt.Errorf("failed to store message: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int("message_id", created.ID).
+		Msg("Contact message stored")
+
+	return nil
+}
+
+// verifyFormToken checks the token signature, age and expiry and returns
+// its payload
+func (s *contactService) verifyFormToken(tenantID int, token string) ([]byte, error) {
+	encodedPayload, encodedSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, invalidFormToken()
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != formTokenTimeLength+formTokenNonceLength {
+		return nil, invalidFormToken()
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || !hmac.Equal(sig, s.sign(tenantID, payload)) {
+		return nil, invalidFormToken()
+	}
+
+	issuedAt := time.Unix(int64(binary.BigEndian.Uint64(payload)), 0)
+	age := s.now().Sub(issuedAt)
+
+	if age > s.config.TokenTTL {
+		return nil, invalidFormToken()
+	}
+
+	// Humans need a few seconds to fill in the form
+	if age < s.config.MinSubmitTime {
+		return nil, apperrors.Validation("Request validation failed", map[string]interface{}{
+			"form_token": "form was submitted too quickly, please try again",
+		})
+	}
+
+	return payload, nil
+}
+
+// invalidFormToken is the error for forged, expired and reused tokens
+func invalidFormToken() error {
+	return apperrors.Validation("Request validation failed", map[string]interface{}{
+		"form_token": "form_token is invalid or expired, please reload the form",
+	})
+}
+
+// sign returns the HMAC of the token payload bound to the tenant
+func (s *contactService) sign(tenantID int, payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	_ = binary.Write(mac, binary.BigEndian, int64(tenantID))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// notificationBody renders the email sent to the portfolio owner
+func notificationBody(msg models.Message) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "New message from your portfolio contact form.\n\n")
+	fmt.Fprintf(&b, "Name: %s\n", msg.Name)
+	fmt.Fprintf(&b, "Email: %s\n", msg.Email)
+	fmt.Fprintf(&b, "Subject: %s\n\n", msg.Subject)
+	b.WriteString(msg.Body)
+	b.WriteString("\n")
+	return b.String()
+}
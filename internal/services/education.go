@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+)
+
+type EducationService interface {
+	GetAllEducation(ctx context.Context, tenantID int, scope models.Scope) ([]models.Education, error)
+}
+
+type educationService struct {
+	educationRepo repositories.EducationRepository
+	localizer     Localizer
+}
+
+func NewEducationService(educationRepo repositories.EducationRepository, localizer Localizer) EducationService {
+	return &educationService{
+		educationRepo: educationRepo,
+		localizer:     localizer,
+	}
+}
+
+func (s *educationService) GetAllEducation(ctx context.Context, tenantID int, scope models.Scope) ([]models.Education, error) {
+	ctx, span := tracer.Start(ctx, "EducationService.GetAllEducation")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Msg("Getting all education")
+
+	education, err := s.educationRepo.GetAllEducation(ctx, tenantID, scope)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get education from repository")
+		return nil, fmt.Errorf("failed to get education: %w", err)
+	}
+
+	if err := s.localizer.LocalizeEducation(ctx, tenantID, education); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to localize education")
+		return nil, err
+	}
+
+	zerolog.Ctx(ctx).Debug().
+		Int("count", len(education)).
+		Msg("Education retrieved successfully")
+
+	return education, nil
+}
@@ -4,62 +4,86 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"portfolio-backend/internal/database/repositories"
 	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
 )
 
 type ExperienceService interface {
-	GetAllExperiences(ctx context.Context) ([]models.Experience, error)
-	GetExperienceByID(ctx context.Context, id int) (*models.Experience, error)
+	GetAllExperiences(ctx context.Context, tenantID int, scope models.Scope) ([]models.Experience, error)
+	GetExperienceByID(ctx context.Context, tenantID int, id int, scope models.Scope) (*models.Experience, error)
 }
 
 type experienceService struct {
 	experienceRepo repositories.ExperienceRepository
+	localizer      Localizer
 }
 
-func NewExperienceService(experienceRepo repositories.ExperienceRepository) ExperienceService {
+func NewExperienceService(experienceRepo repositories.ExperienceRepository, localizer Localizer) ExperienceService {
 	return &experienceService{
 		experienceRepo: experienceRepo,
+		localizer:      localizer,
 	}
 }
 
-func (s *experienceService) GetAllExperiences(ctx context.Context) ([]models.Experience, error) {
-	log.Debug().Msg("Getting all experiences")
+func (s *experienceService) GetAllExperiences(ctx context.Context, tenantID int, scope models.Scope) ([]models.Experience, error) {
+	ctx, span := tracer.Start(ctx, "ExperienceService.GetAllExperiences")
+	defer span.End()
 
-	experiences, err := s.experienceRepo.GetAllExperiences(ctx)
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Msg("Getting all experiences")
+
+	experiences, err := s.experienceRepo.GetAllExperiences(ctx, tenantID, scope)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get experiences from repository")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get experiences from repository")
 		return nil, fmt.Errorf("failed to get experiences: %w", err)
 	}
 
-	log.Debug().
+	if err := s.localizer.LocalizeExperiences(ctx, tenantID, experiences); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to localize experiences")
+		return nil, err
+	}
+
+	zerolog.Ctx(ctx).Debug().
 		Int("count", len(experiences)).
 		Msg("Experiences retrieved successfully")
 
 	return experiences, nil
 }
 
-func (s *experienceService) GetExperienceByID(ctx context.Context, id int) (*models.Experience, error) {
-	log.Debug().
+func (s *experienceService) GetExperienceByID(ctx context.Context, tenantID int, id int, scope models.Scope) (*models.Experience, error) {
+	ctx, span := tracer.Start(ctx, "ExperienceService.GetExperienceByID")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
 		Int("id", id).
 		Msg("Getting experience by ID")
 
 	if id <= 0 {
-		return nil, fmt.Errorf("invalid experience ID: %d", id)
+		return nil, apperrors.Validation(fmt.Sprintf("invalid experience ID: %d", id))
 	}
 
-	experience, err := s.experienceRepo.GetExperienceByID(ctx, id)
+	experience, err := s.experienceRepo.GetExperienceByID(ctx, tenantID, id, scope)
 	if err != nil {
-		log.Error().
+		zerolog.Ctx(ctx).Error().
 			Err(err).
 			Int("id", id).
 			Msg("Failed to get experience from repository")
 		return nil, fmt.Errorf("failed to get experience: %w", err)
 	}
 
-	log.Debug().
+	localized := []models.Experience{*experience}
+	if err := s.localizer.LocalizeExperiences(ctx, tenantID, localized); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to localize experience")
+		return nil, err
+	}
+	experience = &localized[0]
+
+	zerolog.Ctx(ctx).Debug().
 		Int("id", experience.ID).
 		Str("company", experience.Company).
 		Str("position", experience.Position).
@@ -4,44 +4,69 @@ import (
 	"context"
 	"time"
 
-	"portfolio-backend/internal/database"
+	"portfolio-backend/internal/buildinfo"
+	"portfolio-backend/internal/health"
 	"portfolio-backend/internal/models"
 )
 
 type HealthService interface {
 	CheckHealth(ctx context.Context) (*models.HealthResponse, error)
+	Probe(ctx context.Context, probe health.Probe) health.Report
 }
 
 type healthService struct {
-	db *database.DB
+	registry *health.Registry
 }
 
-func NewHealthService(db *database.DB) HealthService {
+func NewHealthService(registry *health.Registry) HealthService {
 	return &healthService{
-		db: db,
+		registry: registry,
 	}
 }
 
+// CheckHealth summarizes the readiness checks for /v1/health. The error is
+// that of the first failed critical check.
 func (s *healthService) CheckHealth(ctx context.Context) (*models.HealthResponse, error) {
-	components := make(map[string]string)
+	ctx, span := tracer.Start(ctx, "HealthService.CheckHealth")
+	defer span.End()
+
+	report := s.registry.Run(ctx, health.Readiness)
+	build := buildinfo.Get()
 
-	// Check database health
-	if err := s.db.Health(ctx); err != nil {
-		components["database"] = "unhealthy"
-		return &models.HealthResponse{
-			Status:     "unhealthy",
-			Timestamp:  time.Now(),
-			Version:    "1.0.0", // This could be injected from build flags
-			Components: components,
-		}, err
+	var err error
+	components := make(map[string]string)
+	for _, result := range report.Checks {
+		if result.Status != health.StatusOK {
+			components[result.Name] = "unhealthy"
+			if result.Critical && err == nil {
+				err = result.Err()
+			}
+			continue
+		}
+		components[result.Name] = "healthy"
 	}
 
-	components["database"] = "healthy"
+	status := "healthy"
+	switch report.Status {
+	case health.StatusFailing:
+		status = "unhealthy"
+	case health.StatusDegraded:
+		status = "degraded"
+	}
 
 	return &models.HealthResponse{
-		Status:     "healthy",
+		Status:     status,
 		Timestamp:  time.Now(),
-		Version:    "1.0.0",
+		Version:    build.Version,
+		Commit:     build.Commit,
 		Components: components,
-	}, nil
-}
\ No newline at end of file
+	}, err
+}
+
+// Probe runs the checks of a Kubernetes-style probe
+func (s *healthService) Probe(ctx context.Context, probe health.Probe) health.Report {
+	ctx, span := tracer.Start(ctx, "HealthService.Probe")
+	defer span.End()
+
+	return s.registry.Run(ctx, probe)
+}
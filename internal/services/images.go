@@ -0,0 +1,142 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/imaging"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/storage"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// ImageService turns uploaded images into resized variants in the blob store
+// and serves them back
+type ImageService interface {
+	UploadProjectImage(ctx context.Context, tenantID int, projectID int, data []byte) (*models.ProjectImage, error)
+	OpenImage(ctx context.Context, key string) (io.ReadCloser, *storage.BlobInfo, error)
+}
+
+type imageService struct {
+	projectRepo repositories.ProjectRepository
+	store       storage.BlobStore
+	config      config.UploadsConfig
+	revisions   RevisionService
+}
+
+func NewImageService(projectRepo repositories.ProjectRepository, store storage.BlobStore, cfg config.UploadsConfig, revisions RevisionService) ImageService {
+	return &imageService{
+		projectRepo: projectRepo,
+		store:       store,
+		config:      cfg,
+		revisions:   revisions,
+	}
+}
+
+// UploadProjectImage stores the variants of an uploaded image and points the
+// project's image_url at the largest JPEG. Blob keys include a hash of the
+// upload, so URLs never change content and older revisions keep working.
+func (s *imageService) UploadProjectImage(ctx context.Context, tenantID int, projectID int, data []byte) (*models.ProjectImage, error) {
+	ctx, span := tracer.Start(ctx, "ImageService.UploadProjectImage")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Int("project_id", projectID).
+		Int("size", len(data)).
+		Msg("Uploading project image")
+
+	if projectID <= 0 {
+		return nil, apperrors.Validation(fmt.Sprintf("invalid project ID: %d", projectID))
+	}
+
+	// Fail before the expensive processing if the project does not exist
+	if _, err := s.projectRepo.GetProjectByID(ctx, tenantID, projectID, models.ScopeAll); err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	variants, err := imaging.Process(data, s.config.MaxPixels)
+	switch {
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		return nil, apperrors.Validation("unsupported image", map[string]interface{}{
+			"image": "must be a JPEG, PNG, GIF or WebP image",
+		})
+	case errors.Is(err, imaging.ErrTooLarge):
+		return nil, apperrors.Validation("image too large", map[string]interface{}{
+			"image": fmt.Sprintf("must have at most %d pixels", s.config.MaxPixels),
+		})
+	case err != nil:
+		return nil, fmt.Errorf("failed to process image: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:8])
+
+	image := &models.ProjectImage{}
+	for _, variant := range variants {
+		ext := "jpg"
+		if variant.Format == imaging.FormatWebP {
+			ext = "webp"
+		}
+		key := fmt.Sprintf("projects/%d/%d/%s-%dw.%s", tenantID, projectID, hash, variant.Width, ext)
+
+		if err := s.store.Put(ctx, key, bytes.NewReader(variant.Data), variant.ContentType); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Str("key", key).Msg("Failed to store image variant")
+			return nil, fmt.Errorf("failed to store image: %w", err)
+		}
+
+		url := s.store.URL(key)
+		image.Variants = append(image.Variants, models.ImageVariant{
+			Width:  variant.Width,
+			Height: variant.Height,
+			Format: variant.Format,
+			URL:    url,
+		})
+		if variant.Format == imaging.FormatJPEG {
+			image.ImageURL = url
+		}
+	}
+
+	if _, err := s.projectRepo.UpdateProjectImage(ctx, tenantID, projectID, image.ImageURL); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("project_id", projectID).Msg("Failed to update project image in repository")
+		return nil, fmt.Errorf("failed to update project image: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int("project_id", projectID).
+		Int("variants", len(image.Variants)).
+		Msg("Project image uploaded successfully")
+
+	s.revisions.Prune(ctx, tenantID, models.EntityProject, projectID)
+
+	return image, nil
+}
+
+func (s *imageService) OpenImage(ctx context.Context, key string) (io.ReadCloser, *storage.BlobInfo, error) {
+	ctx, span := tracer.Start(ctx, "ImageService.OpenImage")
+	defer span.End()
+
+	if !storage.ValidKey(key) {
+		return nil, nil, apperrors.NotFound("image not found")
+	}
+
+	body, info, err := s.store.Get(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, apperrors.NotFound("image not found")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open image: %w", err)
+	}
+
+	return body, info, nil
+}
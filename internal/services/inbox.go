@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+type InboxService interface {
+	ListMessages(ctx context.Context, tenantID int, filter models.MessageFilter) ([]models.Message, *models.Pagination, error)
+	GetMessage(ctx context.Context, tenantID int, id int) (*models.Message, error)
+	UpdateMessage(ctx context.Context, tenantID int, id int, req models.UpdateMessageRequest) (*models.Message, error)
+	UpdateMessages(ctx context.Context, tenantID int, req models.BulkUpdateMessagesRequest) (*models.BulkResult, error)
+	DeleteMessage(ctx context.Context, tenantID int, id int) error
+	DeleteMessages(ctx context.Context, tenantID int, ids []int) (*models.BulkResult, error)
+	ExportMessages(ctx context.Context, tenantID int, filter models.MessageFilter) ([]models.Message, error)
+}
+
+type inboxService struct {
+	messageRepo repositories.MessageRepository
+}
+
+func NewInboxService(messageRepo repositories.MessageRepository) InboxService {
+	return &inboxService{
+		messageRepo: messageRepo,
+	}
+}
+
+func (s *inboxService) ListMessages(ctx context.Context, tenantID int, filter models.MessageFilter) ([]models.Message, *models.Pagination, error) {
+	ctx, span := tracer.Start(ctx, "InboxService.ListMessages")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Str("status", filter.Status).
+		Int("page", filter.Page).
+		Msg("Listing messages")
+
+	messages, total, err := s.messageRepo.ListMessages(ctx, tenantID, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to list messages from repository")
+		return nil, nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	return messages, models.NewPagination(filter.Page, filter.PerPage, total), nil
+}
+
+func (s *inboxService) GetMessage(ctx context.Context, tenantID int, id int) (*models.Message, error) {
+	ctx, span := tracer.Start(ctx, "InboxService.GetMessage")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Int("message_id", id).
+		Msg("Getting message")
+
+	msg, err := s.messageRepo.GetMessageByID(ctx, tenantID, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("message_id", id).Msg("Failed to get message from repository")
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return msg, nil
+}
+
+func (s *inboxService) UpdateMessage(ctx context.Context, tenantID int, id int, req models.UpdateMessageRequest) (*models.Message, error) {
+	ctx, span := tracer.Start(ctx, "InboxService.UpdateMessage")
+	defer span.End()
+
+	if req.Status == nil && req.IsSpam == nil {
+		return nil, errNothingToUpdate()
+	}
+
+	if _, err := s.messageRepo.UpdateMessages(ctx, tenantID, []int{id}, req.Status, req.IsSpam); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("message_id", id).Msg("Failed to update message in repository")
+		return nil, fmt.Errorf("failed to update message: %w", err)
+	}
+
+	// Also reports not found when the message does not exist
+	return s.GetMessage(ctx, tenantID, id)
+}
+
+func (s *inboxService) UpdateMessages(ctx context.Context, tenantID int, req models.BulkUpdateMessagesRequest) (*models.BulkResult, error) {
+	ctx, span := tracer.Start(ctx, "InboxService.UpdateMessages")
+	defer span.End()
+
+	if req.Status == nil && req.IsSpam == nil {
+		return nil, errNothingToUpdate()
+	}
+
+	affected, err := s.messageRepo.UpdateMessages(ctx, tenantID, req.IDs, req.Status, req.IsSpam)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to update messages in repository")
+		return nil, fmt.Errorf("failed to update messages: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int64("affected", affected).
+		Msg("Messages updated")
+
+	return &models.BulkResult{Affected: affected}, nil
+}
+
+func (s *inboxService) DeleteMessage(ctx context.Context, tenantID int, id int) error {
+	ctx, span := tracer.Start(ctx, "InboxService.DeleteMessage")
+	defer span.End()
+
+	affected, err := s.messageRepo.DeleteMessages(ctx, tenantID, []int{id})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("message_id", id).Msg("Failed to delete message from repository")
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	if affected == 0 {
+		return apperrors.NotFound("message with id %d not found", id)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int("message_id", id).
+		Msg("Message deleted")
+
+	return nil
+}
+
+func (s *inboxService) DeleteMessages(ctx context.Context, tenantID int, ids []int) (*models.BulkResult, error) {
+	ctx, span := tracer.Start(ctx, "InboxService.DeleteMessages")
+	defer span.End()
+
+	affected, err := s.messageRepo.DeleteMessages(ctx, tenantID, ids)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to delete messages from repository")
+		return nil, fmt.Errorf("failed to delete messages: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int64("affected", affected).
+		Msg("Messages deleted")
+
+	return &models.BulkResult{Affected: affected}, nil
+}
+
+// ExportMessages returns every message matching filter, ignoring pagination
+func (s *inboxService) ExportMessages(ctx context.Context, tenantID int, filter models.MessageFilter) ([]models.Message, error) {
+	ctx, span := tracer.Start(ctx, "InboxService.ExportMessages")
+	defer span.End()
+
+	filter.ListOptions = models.ListOptions{}
+
+	messages, _, err := s.messageRepo.ListMessages(ctx, tenantID, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to export messages from repository")
+		return nil, fmt.Errorf("failed to export messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+func errNothingToUpdate() error {
+	return apperrors.Validation("Request validation failed", map[string]interface{}{
+		"status": "status or is_spam is required",
+	})
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/i18n"
+	"portfolio-backend/internal/models"
+)
+
+// Localizer overlays translations for the locale chain stored in the context
+type Localizer interface {
+	LocalizeProfile(ctx context.Context, tenantID int, profile *models.Profile) error
+	LocalizeExperiences(ctx context.Context, tenantID int, experiences []models.Experience) error
+	LocalizeSkills(ctx context.Context, tenantID int, skills []models.Skill) error
+	LocalizeEducation(ctx context.Context, tenantID int, education []models.Education) error
+	LocalizeCertifications(ctx context.Context, tenantID int, certifications []models.Certification) error
+	LocalizeProjects(ctx context.Context, tenantID int, projects []models.Project) error
+}
+
+type localizer struct {
+	translationRepo repositories.TranslationRepository
+	defaultLocale   string
+}
+
+func NewLocalizer(translationRepo repositories.TranslationRepository, defaultLocale string) Localizer {
+	return &localizer{
+		translationRepo: translationRepo,
+		defaultLocale:   defaultLocale,
+	}
+}
+
+// translationSet holds the translations of one entity type for a locale chain
+type translationSet struct {
+	ctx           context.Context
+	values        map[int]map[string]map[string]string // entity ID -> field -> locale -> value
+	locales       []string
+	defaultLocale string
+}
+
+// pick returns the value for the first locale in the chain that has one.
+// The default locale ends the search because base columns already hold it.
+// The locale picked is recorded for Content-Language.
+func (t *translationSet) pick(id int, field string, current string) string {
+	for _, locale := range t.locales {
+		if locale == t.defaultLocale {
+			break
+		}
+		if value, ok := t.values[id][field][locale]; ok {
+			i18n.RecordServed(t.ctx, locale)
+			return value
+		}
+	}
+	i18n.RecordServed(t.ctx, t.defaultLocale)
+	return current
+}
+
+// pickPtr is pick for nullable fields; a translation never creates a value that is absent in the base row
+func (t *translationSet) pickPtr(id int, field string, current *string) *string {
+	if current == nil {
+		return nil
+	}
+	value := t.pick(id, field, *current)
+	return &value
+}
+
+// load fetches translations for the entities, or returns nil when the
+// preferred locale is the default one and no overlay is needed
+func (l *localizer) load(ctx context.Context, tenantID int, entityType string, ids []int) (*translationSet, error) {
+	locales := i18n.FromContext(ctx)
+	if len(locales) == 0 || locales[0] == l.defaultLocale || len(ids) == 0 {
+		i18n.RecordServed(ctx, l.defaultLocale)
+		return nil, nil
+	}
+
+	translations, err := l.translationRepo.GetTranslations(ctx, tenantID, entityType, ids, locales)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s translations: %w", entityType, err)
+	}
+
+	set := &translationSet{
+		ctx:           ctx,
+		values:        make(map[int]map[string]map[string]string),
+		locales:       locales,
+		defaultLocale: l.defaultLocale,
+	}
+	for _, t := range translations {
+		if set.values[t.EntityID] == nil {
+			set.values[t.EntityID] = make(map[string]map[string]string)
+		}
+		if set.values[t.EntityID][t.Field] == nil {
+			set.values[t.EntityID][t.Field] = make(map[string]string)
+		}
+		set.values[t.EntityID][t.Field][t.Locale] = t.Value
+	}
+
+	return set, nil
+}
+
+func (l *localizer) LocalizeProfile(ctx context.Context, tenantID int, profile *models.Profile) error {
+	ctx, span := tracer.Start(ctx, "Localizer.LocalizeProfile")
+	defer span.End()
+
+	set, err := l.load(ctx, tenantID, models.EntityProfile, []int{profile.ID})
+	if err != nil || set == nil {
+		return err
+	}
+
+	profile.Title = set.pick(profile.ID, "title", profile.Title)
+	profile.Summary = set.pick(profile.ID, "summary", profile.Summary)
+	profile.Location = set.pick(profile.ID, "location", profile.Location)
+
+	return nil
+}
+
+func (l *localizer) LocalizeExperiences(ctx context.Context, tenantID int, experiences []models.Experience) error {
+	ctx, span := tracer.Start(ctx, "Localizer.LocalizeExperiences")
+	defer span.End()
+
+	ids := make([]int, len(experiences))
+	for i := range experiences {
+		ids[i] = experiences[i].ID
+	}
+
+	set, err := l.load(ctx, tenantID, models.EntityExperience, ids)
+	if err != nil || set == nil {
+		return err
+	}
+
+	for i := range experiences {
+		exp := &experiences[i]
+		exp.Position = set.pick(exp.ID, "position", exp.Position)
+		exp.Description = set.pick(exp.ID, "description", exp.Description)
+		exp.Location = set.pick(exp.ID, "location", exp.Location)
+	}
+
+	return nil
+}
+
+func (l *localizer) LocalizeSkills(ctx context.Context, tenantID int, skills []models.Skill) error {
+	ctx, span := tracer.Start(ctx, "Localizer.LocalizeSkills")
+	defer span.End()
+
+	ids := make([]int, len(skills))
+	for i := range skills {
+		ids[i] = skills[i].ID
+	}
+
+	set, err := l.load(ctx, tenantID, models.EntitySkill, ids)
+	if err != nil || set == nil {
+		return err
+	}
+
+	for i := range skills {
+		skill := &skills[i]
+		skill.Description = set.pickPtr(skill.ID, "description", skill.Description)
+	}
+
+	return nil
+}
+
+func (l *localizer) LocalizeEducation(ctx context.Context, tenantID int, education []models.Education) error {
+	ctx, span := tracer.Start(ctx, "Localizer.LocalizeEducation")
+	defer span.End()
+
+	ids := make([]int, len(education))
+	for i := range education {
+		ids[i] = education[i].ID
+	}
+
+	set, err := l.load(ctx, tenantID, models.EntityEducation, ids)
+	if err != nil || set == nil {
+		return err
+	}
+
+	for i := range education {
+		edu := &education[i]
+		edu.Degree = set.pick(edu.ID, "degree", edu.Degree)
+		edu.Field = set.pick(edu.ID, "field", edu.Field)
+		edu.Description = set.pickPtr(edu.ID, "description", edu.Description)
+	}
+
+	return nil
+}
+
+func (l *localizer) LocalizeCertifications(ctx context.Context, tenantID int, certifications []models.Certification) error {
+	ctx, span := tracer.Start(ctx, "Localizer.LocalizeCertifications")
+	defer span.End()
+
+	ids := make([]int, len(certifications))
+	for i := range certifications {
+		ids[i] = certifications[i].ID
+	}
+
+	set, err := l.load(ctx, tenantID, models.EntityCertification, ids)
+	if err != nil || set == nil {
+		return err
+	}
+
+	for i := range certifications {
+		cert := &certifications[i]
+		cert.Description = set.pickPtr(cert.ID, "description", cert.Description)
+	}
+
+	return nil
+}
+
+func (l *localizer) LocalizeProjects(ctx context.Context, tenantID int, projects []models.Project) error {
+	ctx, span := tracer.Start(ctx, "Localizer.LocalizeProjects")
+	defer span.End()
+
+	ids := make([]int, len(projects))
+	for i := range projects {
+		ids[i] = projects[i].ID
+	}
+
+	set, err := l.load(ctx, tenantID, models.EntityProject, ids)
+	if err != nil || set == nil {
+		return err
+	}
+
+	for i := range projects {
+		project := &projects[i]
+		project.Title = set.pick(project.ID, "title", project.Title)
+		project.Description = set.pick(project.ID, "description", project.Description)
+		project.ShortDescription = set.pickPtr(project.ID, "short_description", project.ShortDescription)
+	}
+
+	return nil
+}
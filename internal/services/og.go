@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/i18n"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/ogimage"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// OGImageService renders OpenGraph share cards, caching them on disk until
+// the content they show changes
+type OGImageService interface {
+	ProjectCard(ctx context.Context, tenantID int, id int) ([]byte, error)
+	ProfileCard(ctx context.Context, tenantID int) ([]byte, error)
+}
+
+type ogImageService struct {
+	projects ProjectService
+	profiles ProfileService
+	renderer *ogimage.Renderer
+	cache    *ogimage.Cache
+}
+
+func NewOGImageService(projects ProjectService, profiles ProfileService, renderer *ogimage.Renderer, cache *ogimage.Cache) OGImageService {
+	return &ogImageService{
+		projects: projects,
+		profiles: profiles,
+		renderer: renderer,
+		cache:    cache,
+	}
+}
+
+// ProjectCard renders the card of a published project. The card also shows
+// the owner's name, so it is keyed by the updated_at of both.
+func (s *ogImageService) ProjectCard(ctx context.Context, tenantID int, id int) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "OGImageService.ProjectCard")
+	defer span.End()
+
+	project, err := s.projects.GetProjectByID(ctx, tenantID, id, models.ScopePublished)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.optionalProfile(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	card := ogimage.Card{
+		Title:       project.Title,
+		Description: project.Description,
+		Chips:       project.Technologies,
+	}
+	if project.ShortDescription != nil && *project.ShortDescription != "" {
+		card.Description = *project.ShortDescription
+	}
+
+	version := fmt.Sprintf("%d", project.UpdatedAt.UnixNano())
+	if profile != nil {
+		card.Footer = profile.Name
+		version += fmt.Sprintf("-%d", profile.UpdatedAt.UnixNano())
+	}
+
+	name := fmt.Sprintf("%d/project-%d-%s", tenantID, id, cardLocale(ctx))
+	return s.render(ctx, name, version, card)
+}
+
+// ProfileCard renders the card of the portfolio owner
+func (s *ogImageService) ProfileCard(ctx context.Context, tenantID int) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "OGImageService.ProfileCard")
+	defer span.End()
+
+	profile, err := s.profiles.GetProfile(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	card := ogimage.Card{
+		Title:       profile.Name,
+		Description: profile.Summary,
+		Footer:      profile.Title,
+	}
+
+	name := fmt.Sprintf("%d/profile-%s", tenantID, cardLocale(ctx))
+	version := fmt.Sprintf("%d", profile.UpdatedAt.UnixNano())
+	return s.render(ctx, name, version, card)
+}
+
+// render returns the cached card version or renders and caches it. The
+// version is extended with a hash of the localized card text, since a
+// translation edit does not touch the updated_at of the translated row. A
+// failed cache write is logged and does not fail the request.
+func (s *ogImageService) render(ctx context.Context, name, version string, card ogimage.Card) ([]byte, error) {
+	version += "-" + cardHash(card)
+
+	if data, ok := s.cache.Get(name, version); ok {
+		return data, nil
+	}
+
+	data, err := s.renderer.Render(card)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("card", name).Msg("Failed to render OG image")
+		return nil, fmt.Errorf("failed to render OG image: %w", err)
+	}
+
+	if err := s.cache.Put(name, version, data); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Str("card", name).Msg("Failed to cache OG image")
+	}
+
+	zerolog.Ctx(ctx).Debug().Str("card", name).Str("version", version).Msg("OG image rendered")
+
+	return data, nil
+}
+
+// optionalProfile returns the tenant's profile, or nil if it has none
+func (s *ogImageService) optionalProfile(ctx context.Context, tenantID int) (*models.Profile, error) {
+	profile, err := s.profiles.GetProfile(ctx, tenantID)
+	if apperrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return profile, err
+}
+
+// cardHash returns a short hash of the text drawn on card
+func cardHash(card ogimage.Card) string {
+	h := sha256.New()
+	for _, field := range append([]string{card.Title, card.Description, card.Footer}, card.Chips...) {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// cardLocale returns the negotiated locale cards are rendered in
+func cardLocale(ctx context.Context) string {
+	if locales := i18n.FromContext(ctx); len(locales) > 0 {
+		return strings.ToLower(locales[0])
+	}
+	return "default"
+}
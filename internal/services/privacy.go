@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog/log"
+
+	"portfolio-backend/internal/auth"
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/models"
+)
+
+// Field visibility levels for anonymous visitors
+const (
+	VisibilityPublic        = "public"
+	VisibilityObfuscated    = "obfuscated"
+	VisibilityAuthenticated = "authenticated"
+)
+
+// PrivacyFilter hides or masks personal fields according to their configured
+// visibility. Requests authenticated with an admin token see every value unmasked.
+type PrivacyFilter interface {
+	FilterProfile(ctx context.Context, profile *models.Profile)
+}
+
+type privacyFilter struct {
+	email    string
+	phone    string
+	linkedIn string
+	location string
+}
+
+func NewPrivacyFilter(cfg config.PrivacyConfig) PrivacyFilter {
+	return &privacyFilter{
+		email:    visibility("profile_email", cfg.ProfileEmail),
+		phone:    visibility("profile_phone", cfg.ProfilePhone),
+		linkedIn: visibility("profile_linkedin", cfg.ProfileLinkedIn),
+		location: visibility("profile_location", cfg.ProfileLocation),
+	}
+}
+
+// visibility validates a configured level, falling back to the most private one
+func visibility(field, value string) string {
+	switch value {
+	case VisibilityPublic, VisibilityObfuscated, VisibilityAuthenticated:
+		return value
+	case "":
+		return VisibilityPublic
+	default:
+		log.Warn().
+			Str("field", field).
+			Str("visibility", value).
+			Msg("Unknown field visibility, hiding field from anonymous visitors")
+		return VisibilityAuthenticated
+	}
+}
+
+func (f *privacyFilter) FilterProfile(ctx context.Context, profile *models.Profile) {
+	if profile == nil {
+		return
+	}
+	if _, ok := auth.ActorFromContext(ctx); ok {
+		return
+	}
+
+	switch f.email {
+	case VisibilityObfuscated:
+		profile.Email = obfuscateEmail(profile.Email)
+	case VisibilityAuthenticated:
+		profile.Email = ""
+	}
+
+	if profile.Phone != nil {
+		switch f.phone {
+		case VisibilityObfuscated:
+			masked := maskPhone(*profile.Phone)
+			profile.Phone = &masked
+		case VisibilityAuthenticated:
+			profile.Phone = nil
+		}
+	}
+
+	// A profile URL cannot be partially shown, so obfuscation hides it too
+	if f.linkedIn != VisibilityPublic {
+		profile.LinkedIn = nil
+	}
+
+	switch f.location {
+	case VisibilityObfuscated:
+		profile.Location = coarseLocation(profile.Location)
+	case VisibilityAuthenticated:
+		profile.Location = ""
+	}
+}
+
+// obfuscateEmail keeps the first character of the local part and the domain:
+// jane.doe@example.com -> j***@example.com
+func obfuscateEmail(email string) string {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" {
+		return "***"
+	}
+	_, size := utf8.DecodeRuneInString(local)
+	return local[:size] + "***@" + domain
+}
+
+// maskPhone replaces every digit except the last four, keeping the formatting:
+// +62 812-3456-7890 -> +** ***-****-7890
+func maskPhone(phone string) string {
+	digits := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+
+	keep := 4
+	if digits <= keep {
+		keep = 0
+	}
+
+	var b strings.Builder
+	seen := 0
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			seen++
+			if seen <= digits-keep {
+				b.WriteByte('*')
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// coarseLocation keeps only the last component: "Jakarta, Indonesia" -> "Indonesia"
+func coarseLocation(location string) string {
+	parts := strings.Split(location, ",")
+	return strings.TrimSpace(parts[len(parts)-1])
+}
@@ -4,37 +4,56 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"portfolio-backend/internal/database/repositories"
 	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
 )
 
 type ProfileService interface {
-	GetProfile(ctx context.Context) (*models.Profile, error)
-	UpdateProfile(ctx context.Context, req models.UpdateProfileRequest) (*models.Profile, error)
+	GetProfile(ctx context.Context, tenantID int) (*models.Profile, error)
+	UpdateProfile(ctx context.Context, tenantID int, req models.UpdateProfileRequest) (*models.Profile, error)
 }
 
 type profileService struct {
 	profileRepo repositories.ProfileRepository
+	localizer   Localizer
+	privacy     PrivacyFilter
+	revisions   RevisionService
 }
 
-func NewProfileService(profileRepo repositories.ProfileRepository) ProfileService {
+func NewProfileService(profileRepo repositories.ProfileRepository, localizer Localizer, privacy PrivacyFilter, revisions RevisionService) ProfileService {
 	return &profileService{
 		profileRepo: profileRepo,
+		localizer:   localizer,
+		privacy:     privacy,
+		revisions:   revisions,
 	}
 }
 
-func (s *profileService) GetProfile(ctx context.Context) (*models.Profile, error) {
-	log.Debug().Msg("Getting profile")
+func (s *profileService) GetProfile(ctx context.Context, tenantID int) (*models.Profile, error) {
+	ctx, span := tracer.Start(ctx, "ProfileService.GetProfile")
+	defer span.End()
 
-	profile, err := s.profileRepo.GetProfile(ctx)
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Msg("Getting profile")
+
+	profile, err := s.profileRepo.GetProfile(ctx, tenantID)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get profile from repository")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get profile from repository")
 		return nil, fmt.Errorf("failed to get profile: %w", err)
 	}
 
-	log.Debug().
+	if err := s.localizer.LocalizeProfile(ctx, tenantID, profile); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to localize profile")
+		return nil, err
+	}
+
+	s.privacy.FilterProfile(ctx, profile)
+
+	zerolog.Ctx(ctx).Debug().
 		Str("name", profile.Name).
 		Str("title", profile.Title).
 		Msg("Profile retrieved successfully")
@@ -42,33 +61,41 @@ func (s *profileService) GetProfile(ctx context.Context) (*models.Profile, error
 	return profile, nil
 }
 
-func (s *profileService) UpdateProfile(ctx context.Context, req models.UpdateProfileRequest) (*models.Profile, error) {
-	log.Debug().
+func (s *profileService) UpdateProfile(ctx context.Context, tenantID int, req models.UpdateProfileRequest) (*models.Profile, error) {
+	ctx, span := tracer.Start(ctx, "ProfileService.UpdateProfile")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
 		Str("name", req.Name).
 		Str("title", req.Title).
 		Msg("Updating profile")
 
 	// Business logic validation can be added here
 	if req.Name == "" {
-		return nil, fmt.Errorf("name is required")
+		return nil, apperrors.Validation("name is required", map[string]interface{}{"name": "name is required"})
 	}
 	if req.Title == "" {
-		return nil, fmt.Errorf("title is required")
+		return nil, apperrors.Validation("title is required", map[string]interface{}{"title": "title is required"})
 	}
 	if req.Email == "" {
-		return nil, fmt.Errorf("email is required")
+		return nil, apperrors.Validation("email is required", map[string]interface{}{"email": "email is required"})
 	}
 
-	profile, err := s.profileRepo.UpdateProfile(ctx, req)
+	profile, err := s.profileRepo.UpdateProfile(ctx, tenantID, req)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to update profile in repository")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to update profile in repository")
 		return nil, fmt.Errorf("failed to update profile: %w", err)
 	}
 
-	log.Info().
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
 		Str("name", profile.Name).
 		Str("title", profile.Title).
 		Msg("Profile updated successfully")
 
+	s.revisions.Prune(ctx, tenantID, models.EntityProfile, profile.ID)
+	s.privacy.FilterProfile(ctx, profile)
+
 	return profile, nil
 }
\ No newline at end of file
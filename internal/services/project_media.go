@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// ProjectMediaService manages the image and video gallery of projects
+type ProjectMediaService interface {
+	AddMedia(ctx context.Context, tenantID int, projectID int, req models.AddProjectMediaRequest) (*models.ProjectMedia, error)
+	ReorderMedia(ctx context.Context, tenantID int, projectID int, req models.ReorderProjectMediaRequest) ([]models.ProjectMedia, error)
+	DeleteMedia(ctx context.Context, tenantID int, projectID int, mediaID int) error
+}
+
+type projectMediaService struct {
+	mediaRepo repositories.ProjectMediaRepository
+}
+
+func NewProjectMediaService(mediaRepo repositories.ProjectMediaRepository) ProjectMediaService {
+	return &projectMediaService{
+		mediaRepo: mediaRepo,
+	}
+}
+
+func (s *projectMediaService) AddMedia(ctx context.Context, tenantID int, projectID int, req models.AddProjectMediaRequest) (*models.ProjectMedia, error) {
+	ctx, span := tracer.Start(ctx, "ProjectMediaService.AddMedia")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Int("project_id", projectID).
+		Str("type", req.Type).
+		Msg("Adding project media")
+
+	if projectID <= 0 {
+		return nil, apperrors.Validation(fmt.Sprintf("invalid project ID: %d", projectID))
+	}
+
+	// Alt text is what screen readers announce, so whitespace does not count
+	req.AltText = strings.TrimSpace(req.AltText)
+	if req.AltText == "" {
+		return nil, apperrors.Validation("alt_text is required", map[string]interface{}{
+			"alt_text": "alt_text is required",
+		})
+	}
+
+	if err := validateMediaURL(req.Type, req.URL); err != nil {
+		return nil, err
+	}
+
+	item, err := s.mediaRepo.AddMedia(ctx, tenantID, projectID, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("project_id", projectID).Msg("Failed to add project media in repository")
+		return nil, fmt.Errorf("failed to add project media: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int("project_id", projectID).
+		Int("id", item.ID).
+		Msg("Project media added successfully")
+
+	return item, nil
+}
+
+func (s *projectMediaService) ReorderMedia(ctx context.Context, tenantID int, projectID int, req models.ReorderProjectMediaRequest) ([]models.ProjectMedia, error) {
+	ctx, span := tracer.Start(ctx, "ProjectMediaService.ReorderMedia")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Int("project_id", projectID).
+		Ints("ids", req.IDs).
+		Msg("Reordering project media")
+
+	if projectID <= 0 {
+		return nil, apperrors.Validation(fmt.Sprintf("invalid project ID: %d", projectID))
+	}
+
+	media, err := s.mediaRepo.ReorderMedia(ctx, tenantID, projectID, req.IDs)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("project_id", projectID).Msg("Failed to reorder project media in repository")
+		return nil, fmt.Errorf("failed to reorder project media: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int("project_id", projectID).
+		Msg("Project media reordered successfully")
+
+	return media, nil
+}
+
+func (s *projectMediaService) DeleteMedia(ctx context.Context, tenantID int, projectID int, mediaID int) error {
+	ctx, span := tracer.Start(ctx, "ProjectMediaService.DeleteMedia")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Int("project_id", projectID).
+		Int("id", mediaID).
+		Msg("Deleting project media")
+
+	if projectID <= 0 {
+		return apperrors.Validation(fmt.Sprintf("invalid project ID: %d", projectID))
+	}
+	if mediaID <= 0 {
+		return apperrors.Validation(fmt.Sprintf("invalid media ID: %d", mediaID))
+	}
+
+	if err := s.mediaRepo.DeleteMedia(ctx, tenantID, projectID, mediaID); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", mediaID).Msg("Failed to delete project media in repository")
+		return fmt.Errorf("failed to delete project media: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int("project_id", projectID).
+		Int("id", mediaID).
+		Msg("Project media deleted successfully")
+
+	return nil
+}
+
+// validateMediaURL accepts absolute http(s) URLs for every item and, for
+// images, root-relative paths such as uploaded /media URLs. Videos are
+// embedded in an iframe and need an absolute https URL.
+func validateMediaURL(mediaType, rawURL string) error {
+	invalid := func(reason string) error {
+		return apperrors.Validation("invalid media url", map[string]interface{}{
+			"url": reason,
+		})
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return invalid("must be a valid URL")
+	}
+
+	switch {
+	case mediaType == models.MediaTypeVideo:
+		if u.Scheme != "https" || u.Host == "" {
+			return invalid("video embed URLs must be absolute https URLs")
+		}
+	case u.Scheme == "":
+		if !strings.HasPrefix(u.Path, "/") || u.Host != "" {
+			return invalid("relative image URLs must start with /")
+		}
+	case u.Scheme != "http" && u.Scheme != "https":
+		return invalid("must be an http or https URL")
+	}
+
+	return nil
+}
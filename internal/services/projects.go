@@ -4,63 +4,101 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"portfolio-backend/internal/database/repositories"
 	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
 )
 
 type ProjectService interface {
-	GetAllProjects(ctx context.Context) ([]models.Project, error)
-	GetProjectByID(ctx context.Context, id int) (*models.Project, error)
-	GetFeaturedProjects(ctx context.Context) ([]models.Project, error)
+	GetAllProjects(ctx context.Context, tenantID int, scope models.Scope, includeMedia bool) ([]models.Project, error)
+	GetProjectByID(ctx context.Context, tenantID int, id int, scope models.Scope) (*models.Project, error)
+	GetFeaturedProjects(ctx context.Context, tenantID int, scope models.Scope, includeMedia bool) ([]models.Project, error)
+	UpdateProject(ctx context.Context, tenantID int, id int, req models.UpdateProjectRequest) (*models.Project, error)
 }
 
 type projectService struct {
 	projectRepo repositories.ProjectRepository
+	mediaRepo   repositories.ProjectMediaRepository
+	localizer   Localizer
+	revisions   RevisionService
 }
 
-func NewProjectService(projectRepo repositories.ProjectRepository) ProjectService {
+func NewProjectService(projectRepo repositories.ProjectRepository, mediaRepo repositories.ProjectMediaRepository, localizer Localizer, revisions RevisionService) ProjectService {
 	return &projectService{
 		projectRepo: projectRepo,
+		mediaRepo:   mediaRepo,
+		localizer:   localizer,
+		revisions:   revisions,
 	}
 }
 
-func (s *projectService) GetAllProjects(ctx context.Context) ([]models.Project, error) {
-	log.Debug().Msg("Getting all projects")
+func (s *projectService) GetAllProjects(ctx context.Context, tenantID int, scope models.Scope, includeMedia bool) ([]models.Project, error) {
+	ctx, span := tracer.Start(ctx, "ProjectService.GetAllProjects")
+	defer span.End()
 
-	projects, err := s.projectRepo.GetAllProjects(ctx)
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Msg("Getting all projects")
+
+	projects, err := s.projectRepo.GetAllProjects(ctx, tenantID, scope)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get projects from repository")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get projects from repository")
 		return nil, fmt.Errorf("failed to get projects: %w", err)
 	}
 
-	log.Debug().
+	if err := s.localizer.LocalizeProjects(ctx, tenantID, projects); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to localize projects")
+		return nil, err
+	}
+
+	if includeMedia {
+		if err := s.attachMedia(ctx, tenantID, projects); err != nil {
+			return nil, err
+		}
+	}
+
+	zerolog.Ctx(ctx).Debug().
 		Int("count", len(projects)).
 		Msg("Projects retrieved successfully")
 
 	return projects, nil
 }
 
-func (s *projectService) GetProjectByID(ctx context.Context, id int) (*models.Project, error) {
-	log.Debug().
+func (s *projectService) GetProjectByID(ctx context.Context, tenantID int, id int, scope models.Scope) (*models.Project, error) {
+	ctx, span := tracer.Start(ctx, "ProjectService.GetProjectByID")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
 		Int("id", id).
 		Msg("Getting project by ID")
 
 	if id <= 0 {
-		return nil, fmt.Errorf("invalid project ID: %d", id)
+		return nil, apperrors.Validation(fmt.Sprintf("invalid project ID: %d", id))
 	}
 
-	project, err := s.projectRepo.GetProjectByID(ctx, id)
+	project, err := s.projectRepo.GetProjectByID(ctx, tenantID, id, scope)
 	if err != nil {
-		log.Error().
+		zerolog.Ctx(ctx).Error().
 			Err(err).
 			Int("id", id).
 			Msg("Failed to get project from repository")
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
-	log.Debug().
+	localized := []models.Project{*project}
+	if err := s.localizer.LocalizeProjects(ctx, tenantID, localized); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to localize project")
+		return nil, err
+	}
+	if err := s.attachMedia(ctx, tenantID, localized); err != nil {
+		return nil, err
+	}
+	project = &localized[0]
+
+	zerolog.Ctx(ctx).Debug().
 		Int("id", project.ID).
 		Str("title", project.Title).
 		Str("status", project.Status).
@@ -70,18 +108,86 @@ func (s *projectService) GetProjectByID(ctx context.Context, id int) (*models.Pr
 	return project, nil
 }
 
-func (s *projectService) GetFeaturedProjects(ctx context.Context) ([]models.Project, error) {
-	log.Debug().Msg("Getting featured projects")
+func (s *projectService) GetFeaturedProjects(ctx context.Context, tenantID int, scope models.Scope, includeMedia bool) ([]models.Project, error) {
+	ctx, span := tracer.Start(ctx, "ProjectService.GetFeaturedProjects")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Msg("Getting featured projects")
 
-	projects, err := s.projectRepo.GetFeaturedProjects(ctx)
+	projects, err := s.projectRepo.GetFeaturedProjects(ctx, tenantID, scope)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get featured projects from repository")
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get featured projects from repository")
 		return nil, fmt.Errorf("failed to get featured projects: %w", err)
 	}
 
-	log.Debug().
+	if err := s.localizer.LocalizeProjects(ctx, tenantID, projects); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to localize featured projects")
+		return nil, err
+	}
+
+	if includeMedia {
+		if err := s.attachMedia(ctx, tenantID, projects); err != nil {
+			return nil, err
+		}
+	}
+
+	zerolog.Ctx(ctx).Debug().
 		Int("count", len(projects)).
 		Msg("Featured projects retrieved successfully")
 
 	return projects, nil
-}
\ No newline at end of file
+}
+func (s *projectService) UpdateProject(ctx context.Context, tenantID int, id int, req models.UpdateProjectRequest) (*models.Project, error) {
+	ctx, span := tracer.Start(ctx, "ProjectService.UpdateProject")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Int("id", id).
+		Str("title", req.Title).
+		Msg("Updating project")
+
+	if req.EndDate != nil && req.EndDate.Before(req.StartDate) {
+		return nil, apperrors.Validation("end_date must not be before start_date", map[string]interface{}{
+			"end_date": "end_date must not be before start_date",
+		})
+	}
+
+	project, err := s.projectRepo.UpdateProject(ctx, tenantID, id, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to update project in repository")
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Int("id", project.ID).
+		Str("title", project.Title).
+		Msg("Project updated successfully")
+
+	s.revisions.Prune(ctx, tenantID, models.EntityProject, project.ID)
+
+	return project, nil
+}
+
+// attachMedia loads the galleries of projects with one query
+func (s *projectService) attachMedia(ctx context.Context, tenantID int, projects []models.Project) error {
+	ids := make([]int, len(projects))
+	for i := range projects {
+		ids[i] = projects[i].ID
+	}
+
+	media, err := s.mediaRepo.ListMedia(ctx, tenantID, ids)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get project media from repository")
+		return fmt.Errorf("failed to get project media: %w", err)
+	}
+
+	for i := range projects {
+		projects[i].Media = media[projects[i].ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// PublicationService changes the publication state of portfolio entries
+type PublicationService interface {
+	SetPublication(ctx context.Context, tenantID int, entityType string, id int, req models.UpdatePublicationRequest) (*models.Publication, error)
+}
+
+type publicationService struct {
+	publicationRepo repositories.PublicationRepository
+}
+
+func NewPublicationService(publicationRepo repositories.PublicationRepository) PublicationService {
+	return &publicationService{
+		publicationRepo: publicationRepo,
+	}
+}
+
+func (s *publicationService) SetPublication(ctx context.Context, tenantID int, entityType string, id int, req models.UpdatePublicationRequest) (*models.Publication, error) {
+	ctx, span := tracer.Start(ctx, "PublicationService.SetPublication")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Str("entity_type", entityType).
+		Int("id", id).
+		Str("publication_status", req.PublicationStatus).
+		Msg("Updating publication")
+
+	if id <= 0 {
+		return nil, apperrors.Validation(fmt.Sprintf("invalid %s ID: %d", entityType, id))
+	}
+	if req.PublishAt != nil && req.UnpublishAt != nil && !req.UnpublishAt.After(*req.PublishAt) {
+		return nil, apperrors.Validation("unpublish_at must be after publish_at", map[string]interface{}{
+			"unpublish_at": "unpublish_at must be after publish_at",
+		})
+	}
+
+	// A future publish_at schedules the entry, and the scheduler publishes it
+	// (and purges cached responses) once the time has come
+	if req.PublicationStatus == models.PublicationPublished && req.PublishAt != nil && req.PublishAt.After(time.Now()) {
+		req.PublicationStatus = models.PublicationDraft
+	}
+
+	publication, err := s.publicationRepo.SetPublication(ctx, tenantID, entityType, id, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("id", id).Msg("Failed to update publication in repository")
+		return nil, fmt.Errorf("failed to update publication: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Str("entity_type", entityType).
+		Int("id", id).
+		Str("publication_status", publication.PublicationStatus).
+		Msg("Publication updated successfully")
+
+	return publication, nil
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/diff"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// RevisionService reads, compares and restores revisions of profiles and
+// projects. Revisions are written by the repositories with each update.
+type RevisionService interface {
+	ListRevisions(ctx context.Context, tenantID int, entityType string, entityID int, opts models.ListOptions) ([]models.Revision, *models.Pagination, error)
+	GetRevision(ctx context.Context, tenantID int, entityType string, entityID int, revision int) (*models.Revision, error)
+	DiffRevisions(ctx context.Context, tenantID int, entityType string, entityID int, from, to int) (*models.RevisionDiff, error)
+	RestoreRevision(ctx context.Context, tenantID int, entityType string, entityID int, revision int) (interface{}, error)
+	ProfileID(ctx context.Context, tenantID int) (int, error)
+	Prune(ctx context.Context, tenantID int, entityType string, entityID int)
+}
+
+type revisionService struct {
+	revisionRepo repositories.RevisionRepository
+	profileRepo  repositories.ProfileRepository
+	projectRepo  repositories.ProjectRepository
+	config       config.RevisionsConfig
+}
+
+func NewRevisionService(revisionRepo repositories.RevisionRepository, profileRepo repositories.ProfileRepository, projectRepo repositories.ProjectRepository, cfg config.RevisionsConfig) RevisionService {
+	return &revisionService{
+		revisionRepo: revisionRepo,
+		profileRepo:  profileRepo,
+		projectRepo:  projectRepo,
+		config:       cfg,
+	}
+}
+
+func (s *revisionService) ListRevisions(ctx context.Context, tenantID int, entityType string, entityID int, opts models.ListOptions) ([]models.Revision, *models.Pagination, error) {
+	ctx, span := tracer.Start(ctx, "RevisionService.ListRevisions")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Str("entity_type", entityType).
+		Int("entity_id", entityID).
+		Msg("Listing revisions")
+
+	revisions, total, err := s.revisionRepo.ListRevisions(ctx, tenantID, entityType, entityID, opts)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to list revisions from repository")
+		return nil, nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	return revisions, models.NewPagination(opts.Page, opts.PerPage, total), nil
+}
+
+func (s *revisionService) GetRevision(ctx context.Context, tenantID int, entityType string, entityID int, revision int) (*models.Revision, error) {
+	ctx, span := tracer.Start(ctx, "RevisionService.GetRevision")
+	defer span.End()
+
+	rev, err := s.revisionRepo.GetRevision(ctx, tenantID, entityType, entityID, revision)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("revision", revision).Msg("Failed to get revision from repository")
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return rev, nil
+}
+
+func (s *revisionService) DiffRevisions(ctx context.Context, tenantID int, entityType string, entityID int, from, to int) (*models.RevisionDiff, error) {
+	ctx, span := tracer.Start(ctx, "RevisionService.DiffRevisions")
+	defer span.End()
+
+	fromRev, err := s.GetRevision(ctx, tenantID, entityType, entityID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRev, err := s.GetRevision(ctx, tenantID, entityType, entityID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diff.Fields(fromRev.Snapshot, toRev.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff revisions: %w", err)
+	}
+
+	return &models.RevisionDiff{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+// RestoreRevision writes the snapshot of a revision back to the entity,
+// which records a new revision
+func (s *revisionService) RestoreRevision(ctx context.Context, tenantID int, entityType string, entityID int, revision int) (interface{}, error) {
+	ctx, span := tracer.Start(ctx, "RevisionService.RestoreRevision")
+	defer span.End()
+
+	rev, err := s.GetRevision(ctx, tenantID, entityType, entityID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored interface{}
+
+	switch snapshot := rev.Snapshot.(type) {
+	case *models.Profile:
+		restored, err = s.profileRepo.UpdateProfile(ctx, tenantID, models.UpdateProfileRequest{
+			Name:     snapshot.Name,
+			Title:    snapshot.Title,
+			Location: snapshot.Location,
+			Email:    snapshot.Email,
+			Phone:    snapshot.Phone,
+			LinkedIn: snapshot.LinkedIn,
+			Summary:  snapshot.Summary,
+		})
+	case *models.Project:
+		restored, err = s.projectRepo.UpdateProject(ctx, tenantID, entityID, models.UpdateProjectRequest{
+			Title:            snapshot.Title,
+			Description:      snapshot.Description,
+			ShortDescription: snapshot.ShortDescription,
+			Technologies:     snapshot.Technologies,
+			GitHubURL:        snapshot.GitHubURL,
+			LiveURL:          snapshot.LiveURL,
+			ImageURL:         snapshot.ImageURL,
+			StartDate:        snapshot.StartDate,
+			EndDate:          snapshot.EndDate,
+			Status:           snapshot.Status,
+			Featured:         snapshot.Featured,
+			SortOrder:        snapshot.SortOrder,
+		})
+	default:
+		return nil, apperrors.Validation(fmt.Sprintf("revisions of %s cannot be restored", entityType))
+	}
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Int("revision", revision).Msg("Failed to restore revision")
+		return nil, fmt.Errorf("failed to restore revision: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Str("entity_type", entityType).
+		Int("entity_id", entityID).
+		Int("revision", revision).
+		Msg("Revision restored successfully")
+
+	s.Prune(ctx, tenantID, entityType, entityID)
+
+	return restored, nil
+}
+
+// ProfileID returns the ID of the tenant's profile, which profile routes do not carry
+func (s *revisionService) ProfileID(ctx context.Context, tenantID int) (int, error) {
+	ctx, span := tracer.Start(ctx, "RevisionService.ProfileID")
+	defer span.End()
+
+	profile, err := s.profileRepo.GetProfile(ctx, tenantID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get profile: %w", err)
+	}
+	return profile.ID, nil
+}
+
+// Prune applies the retention rules to the revisions of an entity. Failures
+// are only logged; the next update prunes again.
+func (s *revisionService) Prune(ctx context.Context, tenantID int, entityType string, entityID int) {
+	ctx, span := tracer.Start(ctx, "RevisionService.Prune")
+	defer span.End()
+
+	var olderThan time.Time
+	if s.config.MaxAge > 0 {
+		olderThan = time.Now().Add(-s.config.MaxAge)
+	}
+	if s.config.MaxCount <= 0 && olderThan.IsZero() {
+		return
+	}
+
+	pruned, err := s.revisionRepo.PruneRevisions(ctx, tenantID, entityType, entityID, s.config.MaxCount, olderThan)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("entity_type", entityType).
+			Int("entity_id", entityID).
+			Msg("Failed to prune revisions")
+		return
+	}
+
+	if pruned > 0 {
+		zerolog.Ctx(ctx).Debug().
+			Str("entity_type", entityType).
+			Int("entity_id", entityID).
+			Int64("pruned", pruned).
+			Msg("Revisions pruned")
+	}
+}
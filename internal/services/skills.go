@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+)
+
+type SkillService interface {
+	GetAllSkills(ctx context.Context, tenantID int, scope models.Scope) ([]models.Skill, error)
+	GetSkillsByCategory(ctx context.Context, tenantID int, scope models.Scope) ([]models.SkillCategory, error)
+}
+
+type skillService struct {
+	skillRepo repositories.SkillRepository
+	localizer Localizer
+}
+
+func NewSkillService(skillRepo repositories.SkillRepository, localizer Localizer) SkillService {
+	return &skillService{
+		skillRepo: skillRepo,
+		localizer: localizer,
+	}
+}
+
+func (s *skillService) GetAllSkills(ctx context.Context, tenantID int, scope models.Scope) ([]models.Skill, error) {
+	ctx, span := tracer.Start(ctx, "SkillService.GetAllSkills")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Msg("Getting all skills")
+
+	skills, err := s.skillRepo.GetAllSkills(ctx, tenantID, scope)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get skills from repository")
+		return nil, fmt.Errorf("failed to get skills: %w", err)
+	}
+
+	if err := s.localizer.LocalizeSkills(ctx, tenantID, skills); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to localize skills")
+		return nil, err
+	}
+
+	zerolog.Ctx(ctx).Debug().
+		Int("count", len(skills)).
+		Msg("Skills retrieved successfully")
+
+	return skills, nil
+}
+
+func (s *skillService) GetSkillsByCategory(ctx context.Context, tenantID int, scope models.Scope) ([]models.SkillCategory, error) {
+	ctx, span := tracer.Start(ctx, "SkillService.GetSkillsByCategory")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Msg("Getting skills by category")
+
+	categories, err := s.skillRepo.GetSkillsByCategory(ctx, tenantID, scope)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to get skills by category from repository")
+		return nil, fmt.Errorf("failed to get skills by category: %w", err)
+	}
+
+	for i := range categories {
+		if err := s.localizer.LocalizeSkills(ctx, tenantID, categories[i].Skills); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to localize skills")
+			return nil, err
+		}
+	}
+
+	zerolog.Ctx(ctx).Debug().
+		Int("count", len(categories)).
+		Msg("Skill categories retrieved successfully")
+
+	return categories, nil
+}
@@ -0,0 +1,7 @@
+package services
+
+import "go.opentelemetry.io/otel"
+
+// tracer starts one span per service call so traces show which use case a
+// request's SQL statements belong to
+var tracer = otel.Tracer("portfolio-backend/internal/services")
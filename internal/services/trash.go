@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+// TrashService soft-deletes portfolio entries and manages the trash they are
+// kept in until restored or purged
+type TrashService interface {
+	DeleteEntry(ctx context.Context, tenantID int, entityType string, id int) error
+	ListTrash(ctx context.Context, tenantID int, filter models.TrashFilter) ([]models.TrashItem, *models.Pagination, error)
+	RestoreEntry(ctx context.Context, tenantID int, entityType string, id int) error
+	PurgeEntry(ctx context.Context, tenantID int, entityType string, id int) error
+}
+
+type trashService struct {
+	trashRepo repositories.TrashRepository
+}
+
+func NewTrashService(trashRepo repositories.TrashRepository) TrashService {
+	return &trashService{
+		trashRepo: trashRepo,
+	}
+}
+
+func (s *trashService) DeleteEntry(ctx context.Context, tenantID int, entityType string, id int) error {
+	ctx, span := tracer.Start(ctx, "TrashService.DeleteEntry")
+	defer span.End()
+
+	if id <= 0 {
+		return apperrors.Validation(fmt.Sprintf("invalid %s ID: %d", entityType, id))
+	}
+
+	if err := s.trashRepo.DeleteEntry(ctx, tenantID, entityType, id); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("entity_type", entityType).Int("id", id).Msg("Failed to delete entry in repository")
+		return fmt.Errorf("failed to delete %s: %w", entityType, err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Str("entity_type", entityType).
+		Int("id", id).
+		Msg("Entry moved to trash")
+
+	return nil
+}
+
+func (s *trashService) ListTrash(ctx context.Context, tenantID int, filter models.TrashFilter) ([]models.TrashItem, *models.Pagination, error) {
+	ctx, span := tracer.Start(ctx, "TrashService.ListTrash")
+	defer span.End()
+
+	zerolog.Ctx(ctx).Debug().
+		Int("tenant_id", tenantID).
+		Str("entity_type", filter.EntityType).
+		Int("page", filter.Page).
+		Msg("Listing trash")
+
+	items, total, err := s.trashRepo.ListTrash(ctx, tenantID, filter)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("Failed to list trash from repository")
+		return nil, nil, fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	return items, models.NewPagination(filter.Page, filter.PerPage, total), nil
+}
+
+func (s *trashService) RestoreEntry(ctx context.Context, tenantID int, entityType string, id int) error {
+	ctx, span := tracer.Start(ctx, "TrashService.RestoreEntry")
+	defer span.End()
+
+	if id <= 0 {
+		return apperrors.Validation(fmt.Sprintf("invalid %s ID: %d", entityType, id))
+	}
+
+	if err := s.trashRepo.RestoreEntry(ctx, tenantID, entityType, id); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("entity_type", entityType).Int("id", id).Msg("Failed to restore entry in repository")
+		return fmt.Errorf("failed to restore %s: %w", entityType, err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Str("entity_type", entityType).
+		Int("id", id).
+		Msg("Entry restored from trash")
+
+	return nil
+}
+
+func (s *trashService) PurgeEntry(ctx context.Context, tenantID int, entityType string, id int) error {
+	ctx, span := tracer.Start(ctx, "TrashService.PurgeEntry")
+	defer span.End()
+
+	if id <= 0 {
+		return apperrors.Validation(fmt.Sprintf("invalid %s ID: %d", entityType, id))
+	}
+
+	if err := s.trashRepo.PurgeEntry(ctx, tenantID, entityType, id); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("entity_type", entityType).Int("id", id).Msg("Failed to purge entry in repository")
+		return fmt.Errorf("failed to purge %s: %w", entityType, err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int("tenant_id", tenantID).
+		Str("entity_type", entityType).
+		Int("id", id).
+		Msg("Entry purged from trash")
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"portfolio-backend/internal/config"
+)
+
+// ErrNotFound is returned when a blob does not exist
+var ErrNotFound = errors.New("blob not found")
+
+// BlobInfo describes a stored blob
+type BlobInfo struct {
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+// BlobStore stores uploaded files under slash-separated keys. Blobs are
+// written once and never modified, so their URLs can be cached forever.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error)
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+// NewBlobStore creates the blob store selected by cfg.Driver
+func NewBlobStore(cfg *config.StorageConfig) (BlobStore, error) {
+	switch cfg.Driver {
+	case "local":
+		return NewLocalStore(cfg.LocalPath, cfg.PublicURL)
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %q", cfg.Driver)
+	}
+}
+
+// ValidKey reports whether key is a relative slash-separated path without
+// empty, "." or ".." segments
+func ValidKey(key string) bool {
+	if key == "" || strings.ContainsAny(key, "\\\x00") {
+		return false
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
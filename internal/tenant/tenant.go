@@ -0,0 +1,175 @@
+package tenant
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/database/repositories"
+	"portfolio-backend/internal/models"
+	"portfolio-backend/pkg/apperrors"
+)
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the resolved tenant
+func NewContext(ctx context.Context, t *models.Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the tenant stored in ctx, if any
+func FromContext(ctx context.Context) (*models.Tenant, bool) {
+	t, ok := ctx.Value(contextKey{}).(*models.Tenant)
+	return t, ok && t != nil
+}
+
+// maxCacheEntries bounds the resolver cache. Entries are keyed by tenant
+// slug or configured custom domain, so it only fills up with many tenants.
+const maxCacheEntries = 1024
+
+// cacheEntry holds a resolved tenant until it expires
+type cacheEntry struct {
+	tenant    *models.Tenant
+	expiresAt time.Time
+}
+
+// Resolver maps slugs and request hosts to tenants, caching lookups briefly
+type Resolver struct {
+	repo   repositories.TenantRepository
+	config config.TenancyConfig
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+// NewResolver creates a new tenant resolver
+func NewResolver(repo repositories.TenantRepository, cfg config.TenancyConfig) *Resolver {
+	return &Resolver{
+		repo:   repo,
+		config: cfg,
+		cache:  make(map[string]cacheEntry),
+	}
+}
+
+// BySlug resolves a tenant from its slug, e.g. from /v1/u/:slug routes
+func (r *Resolver) BySlug(ctx context.Context, slug string) (*models.Tenant, error) {
+	slug = strings.ToLower(slug)
+	key := "slug:" + slug
+
+	if t, ok := r.lookup(key); ok {
+		return t, nil
+	}
+
+	t, err := r.repo.GetTenantBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store(key, t)
+	return t, nil
+}
+
+// ByHost resolves a tenant from the request host. Custom domains are checked
+// first, then subdomains of the configured base domain (alice.example.com),
+// and finally the default tenant. The Host header is client-controlled, so
+// only hosts registered as a tenant's custom domain are cached by host;
+// subdomains and the default tenant are cached by slug.
+func (r *Resolver) ByHost(ctx context.Context, host string) (*models.Tenant, error) {
+	host = normalizeHost(host)
+	key := "domain:" + host
+
+	if t, ok := r.lookup(key); ok {
+		return t, nil
+	}
+
+	t, err := r.repo.GetTenantByDomain(ctx, host)
+	if err == nil {
+		r.store(key, t)
+		return t, nil
+	}
+	if !apperrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if slug, ok := r.subdomainSlug(host); ok {
+		return r.BySlug(ctx, slug)
+	}
+
+	if r.config.DefaultTenant != "" {
+		return r.BySlug(ctx, r.config.DefaultTenant)
+	}
+
+	return nil, apperrors.NotFound("no portfolio is hosted at %s", host)
+}
+
+// subdomainSlug extracts the tenant slug from a single-label subdomain of the base domain
+func (r *Resolver) subdomainSlug(host string) (string, bool) {
+	baseDomain := normalizeHost(r.config.BaseDomain)
+	if baseDomain == "" || !strings.HasSuffix(host, "."+baseDomain) {
+		return "", false
+	}
+
+	slug := strings.TrimSuffix(host, "."+baseDomain)
+	if slug == "" || strings.Contains(slug, ".") || slug == "www" {
+		return "", false
+	}
+
+	return slug, true
+}
+
+// lookup returns an unexpired cached tenant, dropping the entry once it has
+// expired
+func (r *Resolver) lookup(key string) (*models.Tenant, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.cache[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(r.cache, key)
+		return nil, false
+	}
+	return entry.tenant, true
+}
+
+// store caches a resolved tenant. Misses are never cached so newly created
+// tenants become reachable immediately. A full cache first drops expired
+// entries, then an arbitrary one.
+func (r *Resolver) store(key string, t *models.Tenant) {
+	if r.config.CacheTTL <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if _, ok := r.cache[key]; !ok && len(r.cache) >= maxCacheEntries {
+		for k, entry := range r.cache {
+			if now.After(entry.expiresAt) {
+				delete(r.cache, k)
+			}
+		}
+		for k := range r.cache {
+			if len(r.cache) < maxCacheEntries {
+				break
+			}
+			delete(r.cache, k)
+		}
+	}
+
+	r.cache[key] = cacheEntry{tenant: t, expiresAt: now.Add(r.config.CacheTTL)}
+}
+
+// normalizeHost lowercases a host and strips the port and trailing dot
+func normalizeHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
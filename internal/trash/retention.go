@@ -0,0 +1,68 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"portfolio-backend/internal/config"
+	"portfolio-backend/internal/database/repositories"
+)
+
+// RetentionJob periodically purges entries that have been in the trash for
+// longer than the configured retention
+type RetentionJob struct {
+	repo   repositories.TrashRepository
+	config config.TrashConfig
+}
+
+// NewRetentionJob creates a new trash retention job
+func NewRetentionJob(repo repositories.TrashRepository, cfg config.TrashConfig) *RetentionJob {
+	return &RetentionJob{
+		repo:   repo,
+		config: cfg,
+	}
+}
+
+// Run purges expired trash until ctx is cancelled. A non-positive retention
+// or interval disables the job and keeps trashed entries until purged by hand.
+func (j *RetentionJob) Run(ctx context.Context) {
+	if j.config.Retention <= 0 || j.config.PurgeInterval <= 0 {
+		log.Info().Msg("Trash retention job disabled")
+		return
+	}
+
+	ticker := time.NewTicker(j.config.PurgeInterval)
+	defer ticker.Stop()
+
+	log.Info().
+		Dur("retention", j.config.Retention).
+		Dur("interval", j.config.PurgeInterval).
+		Msg("Trash retention job started")
+
+	for {
+		j.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Trash retention job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge runs one pass over the expired trash
+func (j *RetentionJob) purge(ctx context.Context) {
+	purged, err := j.repo.PurgeExpired(ctx, time.Now().Add(-j.config.Retention))
+	if err != nil && ctx.Err() == nil {
+		log.Error().Err(err).Msg("Failed to purge expired trash")
+	}
+
+	if purged > 0 {
+		log.Info().
+			Int("purged", purged).
+			Msg("Purged expired trash")
+	}
+}
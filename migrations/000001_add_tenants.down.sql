@@ -0,0 +1,8 @@
+ALTER TABLE projects DROP FOREIGN KEY fk_projects_tenant, DROP KEY idx_projects_tenant, DROP COLUMN tenant_id;
+ALTER TABLE certifications DROP FOREIGN KEY fk_certifications_tenant, DROP KEY idx_certifications_tenant, DROP COLUMN tenant_id;
+ALTER TABLE education DROP FOREIGN KEY fk_education_tenant, DROP KEY idx_education_tenant, DROP COLUMN tenant_id;
+ALTER TABLE skills DROP FOREIGN KEY fk_skills_tenant, DROP KEY idx_skills_tenant, DROP COLUMN tenant_id;
+ALTER TABLE experiences DROP FOREIGN KEY fk_experiences_tenant, DROP KEY idx_experiences_tenant, DROP COLUMN tenant_id;
+ALTER TABLE profiles DROP FOREIGN KEY fk_profiles_tenant, DROP KEY uq_profiles_tenant, DROP COLUMN tenant_id;
+
+DROP TABLE tenants;
@@ -0,0 +1,52 @@
+-- Tenants own every portfolio row. Existing data is assigned to the default tenant.
+CREATE TABLE tenants (
+    id INT NOT NULL AUTO_INCREMENT,
+    slug VARCHAR(63) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    custom_domain VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_tenants_slug (slug),
+    UNIQUE KEY uq_tenants_custom_domain (custom_domain)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT INTO tenants (id, slug, name) VALUES (1, 'default', 'Default');
+
+ALTER TABLE profiles
+    ADD COLUMN tenant_id INT NOT NULL DEFAULT 1 AFTER id,
+    ADD UNIQUE KEY uq_profiles_tenant (tenant_id),
+    ADD CONSTRAINT fk_profiles_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE;
+
+ALTER TABLE experiences
+    ADD COLUMN tenant_id INT NOT NULL DEFAULT 1 AFTER id,
+    ADD KEY idx_experiences_tenant (tenant_id, start_date),
+    ADD CONSTRAINT fk_experiences_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE;
+
+ALTER TABLE skills
+    ADD COLUMN tenant_id INT NOT NULL DEFAULT 1 AFTER id,
+    ADD KEY idx_skills_tenant (tenant_id, category),
+    ADD CONSTRAINT fk_skills_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE;
+
+ALTER TABLE education
+    ADD COLUMN tenant_id INT NOT NULL DEFAULT 1 AFTER id,
+    ADD KEY idx_education_tenant (tenant_id, start_date),
+    ADD CONSTRAINT fk_education_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE;
+
+ALTER TABLE certifications
+    ADD COLUMN tenant_id INT NOT NULL DEFAULT 1 AFTER id,
+    ADD KEY idx_certifications_tenant (tenant_id, issue_date),
+    ADD CONSTRAINT fk_certifications_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE;
+
+ALTER TABLE projects
+    ADD COLUMN tenant_id INT NOT NULL DEFAULT 1 AFTER id,
+    ADD KEY idx_projects_tenant (tenant_id, sort_order),
+    ADD CONSTRAINT fk_projects_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE;
+
+-- New rows must name their tenant explicitly
+ALTER TABLE profiles ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE experiences ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE skills ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE education ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE certifications ALTER COLUMN tenant_id DROP DEFAULT;
+ALTER TABLE projects ALTER COLUMN tenant_id DROP DEFAULT;
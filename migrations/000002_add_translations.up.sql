@@ -0,0 +1,16 @@
+-- Translations of free-text fields. Base columns hold the default locale.
+CREATE TABLE translations (
+    id INT NOT NULL AUTO_INCREMENT,
+    tenant_id INT NOT NULL,
+    entity_type VARCHAR(32) NOT NULL,
+    entity_id INT NOT NULL,
+    field VARCHAR(64) NOT NULL,
+    locale VARCHAR(16) NOT NULL,
+    value TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_translations_field_locale (tenant_id, entity_type, entity_id, field, locale),
+    KEY idx_translations_lookup (tenant_id, entity_type, locale),
+    CONSTRAINT fk_translations_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
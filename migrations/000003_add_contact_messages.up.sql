@@ -0,0 +1,40 @@
+-- Messages submitted through POST /v1/contact
+CREATE TABLE messages (
+    id INT NOT NULL AUTO_INCREMENT,
+    tenant_id INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    email VARCHAR(254) NOT NULL,
+    subject VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    ip_address VARCHAR(45) NOT NULL,
+    user_agent VARCHAR(500) NOT NULL DEFAULT '',
+    status ENUM('unread', 'read', 'archived') NOT NULL DEFAULT 'unread',
+    is_spam BOOLEAN NOT NULL DEFAULT FALSE,
+    spam_reason VARCHAR(100) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY idx_messages_tenant_status (tenant_id, status, created_at),
+    CONSTRAINT fk_messages_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Outgoing mail, delivered with retries by the outbox worker
+CREATE TABLE mail_outbox (
+    id INT NOT NULL AUTO_INCREMENT,
+    tenant_id INT NOT NULL,
+    message_id INT NULL,
+    recipient VARCHAR(254) NOT NULL,
+    reply_to VARCHAR(254) NULL,
+    subject VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    status ENUM('pending', 'sent', 'failed') NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error VARCHAR(1000) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP NULL,
+    PRIMARY KEY (id),
+    KEY idx_mail_outbox_pending (status, next_attempt_at),
+    CONSTRAINT fk_mail_outbox_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE,
+    CONSTRAINT fk_mail_outbox_message FOREIGN KEY (message_id) REFERENCES messages (id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
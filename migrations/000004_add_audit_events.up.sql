@@ -0,0 +1,17 @@
+-- Durable record of every content change, written in the mutation's transaction
+CREATE TABLE audit_events (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id INT NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    correlation_id VARCHAR(128) NULL,
+    entity_type VARCHAR(32) NOT NULL,
+    entity_id INT NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    changes JSON NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_audit_events_entity (tenant_id, entity_type, entity_id, created_at),
+    KEY idx_audit_events_actor (tenant_id, actor, created_at),
+    KEY idx_audit_events_created (tenant_id, created_at),
+    CONSTRAINT fk_audit_events_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,16 @@
+-- Full snapshots of profiles and projects, one per change
+CREATE TABLE revisions (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id INT NOT NULL,
+    entity_type VARCHAR(32) NOT NULL,
+    entity_id INT NOT NULL,
+    revision INT NOT NULL,
+    actor VARCHAR(100) NOT NULL,
+    correlation_id VARCHAR(128) NULL,
+    snapshot JSON NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_revisions_number (tenant_id, entity_type, entity_id, revision),
+    KEY idx_revisions_created (tenant_id, entity_type, entity_id, created_at),
+    CONSTRAINT fk_revisions_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,5 @@
+ALTER TABLE projects DROP KEY idx_projects_publication, DROP COLUMN unpublish_at, DROP COLUMN publish_at, DROP COLUMN publication_status;
+ALTER TABLE certifications DROP KEY idx_certifications_publication, DROP COLUMN unpublish_at, DROP COLUMN publish_at, DROP COLUMN publication_status;
+ALTER TABLE education DROP KEY idx_education_publication, DROP COLUMN unpublish_at, DROP COLUMN publish_at, DROP COLUMN publication_status;
+ALTER TABLE skills DROP KEY idx_skills_publication, DROP COLUMN unpublish_at, DROP COLUMN publish_at, DROP COLUMN publication_status;
+ALTER TABLE experiences DROP KEY idx_experiences_publication, DROP COLUMN unpublish_at, DROP COLUMN publish_at, DROP COLUMN publication_status;
@@ -0,0 +1,31 @@
+-- Publication workflow. Existing rows stay published.
+
+ALTER TABLE experiences
+    ADD COLUMN publication_status ENUM('draft', 'published', 'archived') NOT NULL DEFAULT 'published',
+    ADD COLUMN publish_at TIMESTAMP NULL,
+    ADD COLUMN unpublish_at TIMESTAMP NULL,
+    ADD KEY idx_experiences_publication (publication_status, publish_at, unpublish_at);
+
+ALTER TABLE skills
+    ADD COLUMN publication_status ENUM('draft', 'published', 'archived') NOT NULL DEFAULT 'published',
+    ADD COLUMN publish_at TIMESTAMP NULL,
+    ADD COLUMN unpublish_at TIMESTAMP NULL,
+    ADD KEY idx_skills_publication (publication_status, publish_at, unpublish_at);
+
+ALTER TABLE education
+    ADD COLUMN publication_status ENUM('draft', 'published', 'archived') NOT NULL DEFAULT 'published',
+    ADD COLUMN publish_at TIMESTAMP NULL,
+    ADD COLUMN unpublish_at TIMESTAMP NULL,
+    ADD KEY idx_education_publication (publication_status, publish_at, unpublish_at);
+
+ALTER TABLE certifications
+    ADD COLUMN publication_status ENUM('draft', 'published', 'archived') NOT NULL DEFAULT 'published',
+    ADD COLUMN publish_at TIMESTAMP NULL,
+    ADD COLUMN unpublish_at TIMESTAMP NULL,
+    ADD KEY idx_certifications_publication (publication_status, publish_at, unpublish_at);
+
+ALTER TABLE projects
+    ADD COLUMN publication_status ENUM('draft', 'published', 'archived') NOT NULL DEFAULT 'published',
+    ADD COLUMN publish_at TIMESTAMP NULL,
+    ADD COLUMN unpublish_at TIMESTAMP NULL,
+    ADD KEY idx_projects_publication (publication_status, publish_at, unpublish_at);
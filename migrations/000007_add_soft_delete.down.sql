@@ -0,0 +1,6 @@
+ALTER TABLE messages DROP KEY idx_messages_deleted, DROP COLUMN deleted_at;
+ALTER TABLE projects DROP KEY idx_projects_deleted, DROP COLUMN deleted_at;
+ALTER TABLE certifications DROP KEY idx_certifications_deleted, DROP COLUMN deleted_at;
+ALTER TABLE education DROP KEY idx_education_deleted, DROP COLUMN deleted_at;
+ALTER TABLE skills DROP KEY idx_skills_deleted, DROP COLUMN deleted_at;
+ALTER TABLE experiences DROP KEY idx_experiences_deleted, DROP COLUMN deleted_at;
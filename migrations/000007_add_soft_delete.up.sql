@@ -0,0 +1,25 @@
+-- Soft delete. Rows with deleted_at set are in the trash until purged.
+
+ALTER TABLE experiences
+    ADD COLUMN deleted_at TIMESTAMP NULL,
+    ADD KEY idx_experiences_deleted (tenant_id, deleted_at);
+
+ALTER TABLE skills
+    ADD COLUMN deleted_at TIMESTAMP NULL,
+    ADD KEY idx_skills_deleted (tenant_id, deleted_at);
+
+ALTER TABLE education
+    ADD COLUMN deleted_at TIMESTAMP NULL,
+    ADD KEY idx_education_deleted (tenant_id, deleted_at);
+
+ALTER TABLE certifications
+    ADD COLUMN deleted_at TIMESTAMP NULL,
+    ADD KEY idx_certifications_deleted (tenant_id, deleted_at);
+
+ALTER TABLE projects
+    ADD COLUMN deleted_at TIMESTAMP NULL,
+    ADD KEY idx_projects_deleted (tenant_id, deleted_at);
+
+ALTER TABLE messages
+    ADD COLUMN deleted_at TIMESTAMP NULL,
+    ADD KEY idx_messages_deleted (tenant_id, deleted_at);
@@ -0,0 +1,19 @@
+-- Ordered gallery of images and video embeds shown with a project
+CREATE TABLE project_media (
+    id INT NOT NULL AUTO_INCREMENT,
+    tenant_id INT NOT NULL,
+    project_id INT NOT NULL,
+    media_type ENUM('image', 'video') NOT NULL,
+    url VARCHAR(500) NOT NULL,
+    caption VARCHAR(500) NULL,
+    alt_text VARCHAR(300) NOT NULL,
+    width INT NULL,
+    height INT NULL,
+    position INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    KEY idx_project_media_position (tenant_id, project_id, position),
+    CONSTRAINT fk_project_media_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE,
+    CONSTRAINT fk_project_media_project FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,5 @@
+-- Correlation ID of the request that queued an email, sent as the
+-- X-Correlation-ID header so deliveries can be traced back to it.
+
+ALTER TABLE mail_outbox
+    ADD COLUMN correlation_id VARCHAR(128) NULL AFTER body;
@@ -0,0 +1,10 @@
+-- Nonces of contact form tokens that were already submitted, kept until the
+-- token expires so each token is accepted once across all instances
+CREATE TABLE used_form_tokens (
+    nonce BINARY(16) NOT NULL,
+    tenant_id INT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (nonce),
+    KEY idx_used_form_tokens_expires (expires_at),
+    CONSTRAINT fk_used_form_tokens_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
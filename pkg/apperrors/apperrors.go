@@ -0,0 +1,95 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors shared by repositories, services and the HTTP layer.
+// Use errors.Is to test for them; the response package maps them to status codes.
+var (
+	// ErrNotFound indicates the requested resource does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrConflict indicates the request conflicts with the current state of a resource
+	ErrConflict = errors.New("conflict")
+	// ErrValidation indicates the request failed business validation
+	ErrValidation = errors.New("validation failed")
+	// ErrUnavailable indicates a dependency (e.g. the database) is temporarily unavailable
+	ErrUnavailable = errors.New("service unavailable")
+)
+
+// Error is a domain error carrying a client-safe message
+type Error struct {
+	Kind    error                  // One of the sentinel errors above
+	Message string                 // Client-safe description of the problem
+	Details map[string]interface{} // Optional field-level details
+	Err     error                  // Underlying cause, never shown to clients
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap exposes both the kind and the cause to errors.Is and errors.As
+func (e *Error) Unwrap() []error {
+	errs := []error{e.Kind}
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+	return errs
+}
+
+// NotFound returns an ErrNotFound error with a formatted message
+func NotFound(format string, args ...interface{}) error {
+	return &Error{Kind: ErrNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+// Conflict returns an ErrConflict error with a formatted message
+func Conflict(format string, args ...interface{}) error {
+	return &Error{Kind: ErrConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+// Validation returns an ErrValidation error with optional field details
+func Validation(message string, details ...map[string]interface{}) error {
+	e := &Error{Kind: ErrValidation, Message: message}
+	if len(details) > 0 {
+		e.Details = details[0]
+	}
+	return e
+}
+
+// Unavailable returns an ErrUnavailable error wrapping the underlying cause
+func Unavailable(err error, message string) error {
+	return &Error{Kind: ErrUnavailable, Message: message, Err: err}
+}
+
+// Wrap attaches a kind and client-safe message to an underlying error
+func Wrap(kind error, err error, message string) error {
+	return &Error{Kind: kind, Message: message, Err: err}
+}
+
+// IsNotFound reports whether err is an ErrNotFound error
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// Message returns the client-safe message of the first *Error in the chain
+func Message(err error) (string, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Message, true
+	}
+	return "", false
+}
+
+// Details returns the field-level details of the first *Error in the chain
+func Details(err error) map[string]interface{} {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Details
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"portfolio-backend/pkg/apperrors"
+)
+
+// HandleError maps a domain error to the matching HTTP status and sends it.
+// Known kinds expose their client-safe message; anything else becomes a 500
+// with the given fallback message so internal details never reach clients.
+func HandleError(c *gin.Context, err error, fallbackMessage string) {
+	statusCode := StatusCode(err)
+
+	message := fallbackMessage
+	if statusCode != http.StatusInternalServerError {
+		if msg, ok := apperrors.Message(err); ok {
+			message = msg
+		}
+	}
+
+	if statusCode == http.StatusBadRequest {
+		if details := apperrors.Details(err); details != nil {
+			ValidationError(c, details)
+			return
+		}
+	}
+
+	Error(c, statusCode, err, message)
+}
+
+// StatusCode returns the HTTP status code for a domain error
+func StatusCode(err error) int {
+	switch {
+	case errors.Is(err, apperrors.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, apperrors.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, apperrors.ErrValidation):
+		return http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrUnavailable):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// errorCode returns the machine-readable code sent in the "error" field
+func errorCode(statusCode int, err error) string {
+	if errors.Is(err, apperrors.ErrValidation) {
+		return "validation_failed"
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return "rate_limit_exceeded"
+	case http.StatusInternalServerError:
+		return "internal_server_error"
+	}
+
+	text := http.StatusText(statusCode)
+	if text == "" {
+		return "error"
+	}
+	return strings.ReplaceAll(strings.ToLower(text), " ", "_")
+}
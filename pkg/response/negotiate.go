@@ -0,0 +1,259 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ugorji/go/codec"
+	"gopkg.in/yaml.v3"
+
+	"portfolio-backend/internal/models"
+)
+
+// Media types supported for successful responses
+const (
+	MIMEJSON    = "application/json"
+	MIMEXML     = "application/xml"
+	MIMEYAML    = "application/yaml"
+	MIMEMsgPack = "application/msgpack"
+	MIMECBOR    = "application/cbor"
+)
+
+// format describes one response encoding
+type format struct {
+	mediaType string
+	aliases   []string
+	encode    func(payload interface{}) ([]byte, error)
+}
+
+var (
+	msgpackHandle = &codec.MsgpackHandle{WriteExt: true}
+	cborHandle    = &codec.CborHandle{TimeRFC3339: true}
+)
+
+// formats lists the supported encodings; the first entry is the default
+var formats = []format{
+	{mediaType: MIMEJSON, encode: json.Marshal},
+	{mediaType: MIMEXML, aliases: []string{"text/xml"}, encode: encodeXML},
+	{mediaType: MIMEYAML, aliases: []string{"application/x-yaml", "text/yaml"}, encode: encodeYAML},
+	{mediaType: MIMEMsgPack, aliases: []string{"application/x-msgpack", "application/vnd.msgpack"}, encode: encodeCodec(msgpackHandle)},
+	{mediaType: MIMECBOR, encode: encodeCodec(cborHandle)},
+}
+
+// render sends a payload in the encoding negotiated from the Accept header.
+// Clients that accept none of the supported encodings get 406 Not Acceptable.
+// Error responses are not negotiated: they are always JSON, or problem
+// details, so a failing encoder or an unsupported Accept header can still
+// be reported in a format every client understands.
+func render(c *gin.Context, statusCode int, payload interface{}) {
+	c.Writer.Header().Add("Vary", "Accept")
+
+	f, ok := negotiateFormat(c.GetHeader("Accept"))
+	if !ok {
+		NotAcceptable(c)
+		return
+	}
+
+	// Keep gin's JSON renderer so JSON responses are unchanged
+	if f.mediaType == MIMEJSON {
+		c.JSON(statusCode, payload)
+		return
+	}
+
+	body, err := f.encode(payload)
+	if err != nil {
+		InternalServerError(c, fmt.Errorf("failed to encode %s response: %w", f.mediaType, err), "Failed to encode response")
+		return
+	}
+
+	c.Data(statusCode, f.mediaType, body)
+}
+
+// NotAcceptable sends a 406 Not Acceptable response listing the supported media types
+func NotAcceptable(c *gin.Context) {
+	supported := make([]string, 0, len(formats))
+	for _, f := range formats {
+		supported = append(supported, f.mediaType)
+	}
+
+	Error(c, http.StatusNotAcceptable, fmt.Errorf("unsupported Accept header %q", c.GetHeader("Accept")),
+		"None of the requested media types can be produced",
+		map[string]interface{}{"supported": supported})
+}
+
+// acceptRange is one media range from an Accept header
+type acceptRange struct {
+	mediaType string
+	quality   float64
+}
+
+// negotiateFormat picks the best supported format for an Accept header
+func negotiateFormat(accept string) (format, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return formats[0], true
+	}
+
+	var ranges []acceptRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, acceptRange{mediaType: mediaType, quality: quality})
+	}
+
+	// Highest quality first; on ties more specific ranges win
+	sort.SliceStable(ranges, func(i, j int) bool {
+		if ranges[i].quality != ranges[j].quality {
+			return ranges[i].quality > ranges[j].quality
+		}
+		return strings.Count(ranges[i].mediaType, "*") < strings.Count(ranges[j].mediaType, "*")
+	})
+
+	for _, r := range ranges {
+		for _, f := range formats {
+			if f.matches(r.mediaType) {
+				return f, true
+			}
+		}
+	}
+
+	return format{}, false
+}
+
+// matches reports whether a media range selects this format
+func (f format) matches(mediaRange string) bool {
+	if mediaRange == "*/*" {
+		return true
+	}
+	if strings.HasSuffix(mediaRange, "/*") {
+		return strings.HasPrefix(f.mediaType, strings.TrimSuffix(mediaRange, "*"))
+	}
+	if mediaRange == f.mediaType {
+		return true
+	}
+	for _, alias := range f.aliases {
+		if mediaRange == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeCodec returns an encoder for a ugorji codec handle. The handle honors
+// json struct tags, so field names and omitempty match the JSON output.
+func encodeCodec(handle codec.Handle) func(payload interface{}) ([]byte, error) {
+	return func(payload interface{}) ([]byte, error) {
+		var buf []byte
+		if err := codec.NewEncoderBytes(&buf, handle).Encode(payload); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+}
+
+// encodeYAML converts the JSON representation to YAML so both formats share
+// field names, omitempty handling and timestamp formatting
+func encodeYAML(payload interface{}) ([]byte, error) {
+	jsonBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(jsonBody, &node); err != nil {
+		return nil, err
+	}
+	clearYAMLStyle(&node)
+
+	return yaml.Marshal(&node)
+}
+
+// clearYAMLStyle drops the flow and quoting styles inherited from JSON
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
+
+// xmlResponse encodes models.APIResponse as XML with the data wrapped in a
+// <data> element and slices encoded as one child element per item
+type xmlResponse models.APIResponse
+
+func encodeXML(payload interface{}) ([]byte, error) {
+	if resp, ok := payload.(models.APIResponse); ok {
+		payload = xmlResponse(resp)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r xmlResponse) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "response"}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if err := e.EncodeElement(r.Success, xml.StartElement{Name: xml.Name{Local: "success"}}); err != nil {
+		return err
+	}
+	if r.Message != nil {
+		if err := e.EncodeElement(*r.Message, xml.StartElement{Name: xml.Name{Local: "message"}}); err != nil {
+			return err
+		}
+	}
+
+	data := xml.StartElement{Name: xml.Name{Local: "data"}}
+	if err := e.EncodeToken(data); err != nil {
+		return err
+	}
+	if r.Data != nil {
+		value := reflect.ValueOf(r.Data)
+		if value.Kind() == reflect.Slice {
+			for i := 0; i < value.Len(); i++ {
+				if err := e.Encode(value.Index(i).Interface()); err != nil {
+					return err
+				}
+			}
+		} else if err := e.Encode(r.Data); err != nil {
+			return err
+		}
+	}
+	if err := e.EncodeToken(data.End()); err != nil {
+		return err
+	}
+
+	if r.Pagination != nil {
+		if err := e.Encode(r.Pagination); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
@@ -0,0 +1,105 @@
+package response
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"portfolio-backend/internal/models"
+)
+
+// ProblemContentType is the media type of RFC 9457 problem details
+const ProblemContentType = "application/problem+json"
+
+// problemTypeBase prefixes problem types that carry semantics beyond the status code
+const problemTypeBase = "urn:problem-type:"
+
+// problemDetailsDefault makes problem details the default error format
+var problemDetailsDefault bool
+
+// SetProblemDetails makes problem details the error format for every request,
+// not only those that ask for it with Accept: application/problem+json
+func SetProblemDetails(enabled bool) {
+	problemDetailsDefault = enabled
+}
+
+// writeError sends an error in the format negotiated with the client
+func writeError(c *gin.Context, statusCode int, apiError models.APIError) {
+	apiError.CorrelationID = c.GetString("correlation_id")
+
+	if !wantsProblemDetails(c) {
+		c.JSON(statusCode, apiError)
+		return
+	}
+
+	problem := models.ProblemDetails{
+		Type:     "about:blank",
+		Title:    http.StatusText(statusCode),
+		Status:   statusCode,
+		Detail:   apiError.Message,
+		Instance: c.GetString("correlation_id"),
+		Code:     apiError.Error,
+	}
+
+	var extensions map[string]interface{}
+	switch apiError.Error {
+	case "validation_failed":
+		problem.Type = problemTypeBase + "validation-failed"
+		problem.Errors = apiError.Details
+	case "rate_limit_exceeded":
+		problem.Type = problemTypeBase + "rate-limit-exceeded"
+		extensions = apiError.Details
+	default:
+		extensions = apiError.Details
+	}
+
+	body, err := marshalProblem(problem, extensions)
+	if err != nil {
+		c.JSON(statusCode, apiError)
+		return
+	}
+
+	c.Data(statusCode, ProblemContentType, body)
+}
+
+// marshalProblem encodes a problem with extension members at the top level.
+// Extensions never override the standard members.
+func marshalProblem(problem models.ProblemDetails, extensions map[string]interface{}) ([]byte, error) {
+	if len(extensions) == 0 {
+		return json.Marshal(problem)
+	}
+
+	base, err := json.Marshal(problem)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]interface{}, len(extensions))
+	for key, value := range extensions {
+		members[key] = value
+	}
+	if err := json.Unmarshal(base, &members); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(members)
+}
+
+// wantsProblemDetails reports whether the client should receive problem details
+func wantsProblemDetails(c *gin.Context) bool {
+	if problemDetailsDefault {
+		return true
+	}
+
+	for _, accepted := range strings.Split(c.GetHeader("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err == nil && mediaType == ProblemContentType && params["q"] != "0" {
+			return true
+		}
+	}
+
+	return false
+}
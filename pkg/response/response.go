@@ -1,15 +1,28 @@
 package response
 
 import (
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
 
 	"portfolio-backend/internal/models"
+	"portfolio-backend/internal/redact"
 )
 
-// Success sends a successful API response
+// retryAfter is the delay suggested to clients with 503 responses
+var retryAfter = 5 * time.Second
+
+// SetRetryAfter sets the Retry-After sent with 503 Service Unavailable
+// responses; zero omits the header
+func SetRetryAfter(d time.Duration) {
+	retryAfter = d
+}
+
+// Success sends a successful API response in the encoding negotiated from the Accept header
 func Success(c *gin.Context, data interface{}, message ...string) {
 	response := models.APIResponse{
 		Data:    data,
@@ -20,7 +33,16 @@ func Success(c *gin.Context, data interface{}, message ...string) {
 		response.Message = &message[0]
 	}
 
-	c.JSON(http.StatusOK, response)
+	render(c, http.StatusOK, response)
+}
+
+// Paginated sends a successful API response for one page of a list
+func Paginated(c *gin.Context, data interface{}, pagination *models.Pagination) {
+	render(c, http.StatusOK, models.APIResponse{
+		Data:       data,
+		Success:    true,
+		Pagination: pagination,
+	})
 }
 
 // Created sends a 201 Created response
@@ -34,13 +56,14 @@ func Created(c *gin.Context, data interface{}, message ...string) {
 		response.Message = &message[0]
 	}
 
-	c.JSON(http.StatusCreated, response)
+	render(c, http.StatusCreated, response)
 }
 
-// Error sends an error response
+// Error sends an error response. The underlying error is only logged; clients
+// receive a stable error code derived from the status and the given message.
 func Error(c *gin.Context, statusCode int, err error, message string, details ...map[string]interface{}) {
 	errorResponse := models.APIError{
-		Error:   err.Error(),
+		Error:   errorCode(statusCode, err),
 		Message: message,
 	}
 
@@ -49,7 +72,7 @@ func Error(c *gin.Context, statusCode int, err error, message string, details ..
 	}
 
 	// Log the error
-	log.Error().
+	zerolog.Ctx(c.Request.Context()).Error().
 		Err(err).
 		Str("path", c.Request.URL.Path).
 		Str("method", c.Request.Method).
@@ -57,7 +80,11 @@ func Error(c *gin.Context, statusCode int, err error, message string, details ..
 		Str("message", message).
 		Msg("API error response")
 
-	c.JSON(statusCode, errorResponse)
+	if statusCode == http.StatusServiceUnavailable && retryAfter > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+
+	writeError(c, statusCode, errorResponse)
 }
 
 // BadRequest sends a 400 Bad Request response
@@ -70,6 +97,26 @@ func NotFound(c *gin.Context, err error, message string, details ...map[string]i
 	Error(c, http.StatusNotFound, err, message, details...)
 }
 
+// Conflict sends a 409 Conflict response
+func Conflict(c *gin.Context, err error, message string, details ...map[string]interface{}) {
+	Error(c, http.StatusConflict, err, message, details...)
+}
+
+// RequestEntityTooLarge sends a 413 Request Entity Too Large response
+func RequestEntityTooLarge(c *gin.Context, err error, message string, details ...map[string]interface{}) {
+	Error(c, http.StatusRequestEntityTooLarge, err, message, details...)
+}
+
+// UnsupportedMediaType sends a 415 Unsupported Media Type response
+func UnsupportedMediaType(c *gin.Context, err error, message string, details ...map[string]interface{}) {
+	Error(c, http.StatusUnsupportedMediaType, err, message, details...)
+}
+
+// ServiceUnavailable sends a 503 Service Unavailable response
+func ServiceUnavailable(c *gin.Context, err error, message string, details ...map[string]interface{}) {
+	Error(c, http.StatusServiceUnavailable, err, message, details...)
+}
+
 // InternalServerError sends a 500 Internal Server Error response
 func InternalServerError(c *gin.Context, err error, message string, details ...map[string]interface{}) {
 	Error(c, http.StatusInternalServerError, err, message, details...)
@@ -96,13 +143,13 @@ func TooManyRequests(c *gin.Context, message string, details ...map[string]inter
 		errorResponse.Details = details[0]
 	}
 
-	log.Warn().
+	zerolog.Ctx(c.Request.Context()).Warn().
 		Str("path", c.Request.URL.Path).
 		Str("method", c.Request.Method).
-		Str("client_ip", c.ClientIP()).
+		Str("client_ip", redact.IP(c.ClientIP())).
 		Msg("Rate limit exceeded")
 
-	c.JSON(http.StatusTooManyRequests, errorResponse)
+	writeError(c, http.StatusTooManyRequests, errorResponse)
 }
 
 // ValidationError sends a 400 Bad Request response with validation errors
@@ -113,11 +160,11 @@ func ValidationError(c *gin.Context, validationErrors map[string]interface{}) {
 		Details: validationErrors,
 	}
 
-	log.Warn().
+	zerolog.Ctx(c.Request.Context()).Warn().
 		Str("path", c.Request.URL.Path).
 		Str("method", c.Request.Method).
-		Interface("validation_errors", validationErrors).
+		Interface("validation_errors", redact.Fields(validationErrors)).
 		Msg("Validation error")
 
-	c.JSON(http.StatusBadRequest, errorResponse)
+	writeError(c, http.StatusBadRequest, errorResponse)
 }
\ No newline at end of file
@@ -25,7 +25,8 @@ echo "Build Time: $BUILD_TIME"
 mkdir -p $BUILD_DIR
 
 # Build flags
-LDFLAGS="-X main.version=$VERSION -X main.commit=$COMMIT_SHA -X main.buildTime=$BUILD_TIME -w -s"
+BUILDINFO="portfolio-backend/internal/buildinfo"
+LDFLAGS="-X $BUILDINFO.Version=$VERSION -X $BUILDINFO.Commit=$COMMIT_SHA -X $BUILDINFO.BuildTime=$BUILD_TIME -w -s"
 
 # Clean previous builds
 echo -e "${YELLOW}Cleaning previous builds...${NC}"